@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// rawRequest 通用接口请求，用于调用未单独封装子命令的接口
+type rawRequest struct {
+	url    string
+	values url.Values
+}
+
+// Values implement core.Request interface
+func (r rawRequest) Values(values url.Values) {
+	for k, vs := range r.values {
+		for _, v := range vs {
+			values.Add(k, v)
+		}
+	}
+}
+
+// Url implement core.Request interface
+func (r rawRequest) Url() string {
+	return r.url
+}
+
+// newRawRequest 由<path> [key=value ...]参数构造请求，path可为完整网关地址，同名参数保留多个值
+func newRawRequest(args []string) (rawRequest, error) {
+	if len(args) == 0 {
+		return rawRequest{}, errors.New("call: path is required")
+	}
+	req := rawRequest{
+		url:    strings.TrimPrefix(strings.TrimPrefix(args[0], core.GATEWAY), "/"),
+		values: make(url.Values),
+	}
+	for _, arg := range args[1:] {
+		k, v, ok := strings.Cut(arg, "=")
+		if !ok || k == "" {
+			return rawRequest{}, fmt.Errorf("call: invalid param %q, want key=value", arg)
+		}
+		req.values.Add(k, v)
+	}
+	return req, nil
+}
+
+func runCall(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var post bool
+	fs := flag.NewFlagSet("call", flag.ExitOnError)
+	fs.BoolVar(&post, "post", false, "使用POST请求")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: dataoke call [-post] <path> [key=value ...]")
+		fmt.Fprintln(fs.Output(), "  e.g. dataoke call goods/get-goods-details goodsId=123")
+		fmt.Fprintln(fs.Output(), "  appKey/version/sign are set by the client, use DATAOKE_VERSION to change version")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	req, err := newRawRequest(fs.Args())
+	if err != nil {
+		return nil, err
+	}
+	var ret interface{}
+	if post {
+		if err := clt.Post(req, &ret); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	}
+	if err := clt.Get(req, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+func TestNewRawRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		url     string
+		values  url.Values
+		wantErr bool
+	}{
+		{name: "no path", wantErr: true},
+		{
+			name:   "path only",
+			args:   []string{"goods/get-goods-details"},
+			url:    "goods/get-goods-details",
+			values: url.Values{},
+		},
+		{
+			name:   "full gateway url and params",
+			args:   []string{core.GATEWAY + "tb-service/get-order-details", "queryType=1", "content=a=b", "empty=", "tag=x", "tag=y"},
+			url:    "tb-service/get-order-details",
+			values: url.Values{"queryType": {"1"}, "content": {"a=b"}, "empty": {""}, "tag": {"x", "y"}},
+		},
+		{
+			name:   "leading slash",
+			args:   []string{"/goods/list"},
+			url:    "goods/list",
+			values: url.Values{},
+		},
+		{name: "missing equals", args: []string{"goods/list", "pageId"}, wantErr: true},
+		{name: "empty key", args: []string{"goods/list", "=1"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := newRawRequest(tt.args)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("newRawRequest err = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if req.Url() != tt.url {
+				t.Errorf("Url = %q, want %q", req.Url(), tt.url)
+			}
+			values := url.Values{}
+			req.Values(values)
+			if !reflect.DeepEqual(values, tt.values) {
+				t.Errorf("Values = %v, want %v", values, tt.values)
+			}
+		})
+	}
+}
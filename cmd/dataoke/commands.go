@@ -0,0 +1,267 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bububa/dataoke-go/core"
+	"github.com/bububa/dataoke-go/requests"
+	"github.com/bububa/dataoke-go/util"
+)
+
+func runDetail(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var req requests.GetGoodsDetailsRequest
+	fs := flag.NewFlagSet("detail", flag.ExitOnError)
+	fs.Uint64Var(&req.ID, "id", 0, "大淘客商品id")
+	fs.StringVar(&req.GoodsID, "goods-id", "", "淘宝商品id")
+	fs.Parse(args)
+	if req.ID == 0 && req.GoodsID == "" && fs.NArg() > 0 {
+		req.GoodsID = fs.Arg(0)
+	}
+	if req.ID == 0 && req.GoodsID == "" {
+		return nil, errors.New("detail: -id or -goods-id is required")
+	}
+	ret := new(requests.GoodsDetail)
+	if err := requests.GetGoodsDetails(clt, &req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func runSearch(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var req requests.GetTbServiceRequest
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	fs.StringVar(&req.Keywords, "keywords", "", "查询词")
+	fs.IntVar(&req.PageNo, "page", 1, "第几页")
+	fs.IntVar(&req.PageSize, "page-size", 20, "每页条数，1~100")
+	fs.StringVar(&req.Sort, "sort", "", "排序，如：total_sales_des")
+	fs.BoolVar(&req.HasCoupon, "has-coupon", false, "只返回有优惠券的商品")
+	fs.Float64Var(&req.StartPrice, "start-price", 0, "折扣价下限")
+	fs.Float64Var(&req.EndPrice, "end-price", 0, "折扣价上限")
+	fs.Parse(args)
+	if req.Keywords == "" {
+		req.Keywords = strings.Join(fs.Args(), " ")
+	}
+	if req.Keywords == "" {
+		return nil, errors.New("search: -keywords is required")
+	}
+	var ret []requests.TbkItem
+	if err := requests.GetTbService(clt, &req, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func runConvert(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var req requests.GetPrivilegeLinkRequest
+	fs := flag.NewFlagSet("convert", flag.ExitOnError)
+	fs.StringVar(&req.GoodsID, "goods-id", "", "淘宝商品id")
+	fs.StringVar(&req.CouponID, "coupon-id", "", "优惠券ID")
+	fs.StringVar(&req.Pid, "pid", cfg.Pid, "推广位ID")
+	fs.StringVar(&req.ChannelID, "channel-id", "", "渠道id")
+	fs.StringVar(&req.SpecialID, "special-id", "", "会员运营id")
+	fs.StringVar(&req.ExternalID, "external-id", "", "淘宝客外部用户标记")
+	fs.Parse(args)
+	if req.GoodsID == "" && fs.NArg() > 0 {
+		req.GoodsID = fs.Arg(0)
+	}
+	if req.GoodsID == "" {
+		return nil, errors.New("convert: -goods-id is required")
+	}
+	ret := new(requests.PrivilegeLink)
+	if err := requests.GetPrivilageLink(clt, &req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func runParse(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var content string
+	fs := flag.NewFlagSet("parse", flag.ExitOnError)
+	fs.StringVar(&content, "content", "", "包含淘口令、链接的文本")
+	fs.Parse(args)
+	if content == "" {
+		content = strings.Join(fs.Args(), " ")
+	}
+	if content == "" {
+		return nil, errors.New("parse: -content is required")
+	}
+	ret := new(requests.ParseContentResult)
+	if err := requests.ParseContent(clt, content, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func runOrders(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var (
+		req        requests.GetOrderDetailsRequest
+		start, end string
+		queryType  int
+		memberType int
+		tkStatus   int
+		orderScene int
+	)
+	fs := flag.NewFlagSet("orders", flag.ExitOnError)
+	fs.StringVar(&start, "start", "", "订单查询开始时间，格式："+requests.OrderTimeFormat)
+	fs.StringVar(&end, "end", "", "订单查询结束时间，默认为当前时间，超过3小时将自动切分窗口")
+	fs.IntVar(&queryType, "query-type", int(requests.OrderQueryByCreateTime), "查询时间类型，1：创建时间，2：付款时间，3：结算时间，4：更新时间")
+	fs.IntVar(&memberType, "member-type", 0, "推广者角色类型，2：二方，3：三方")
+	fs.IntVar(&tkStatus, "tk-status", 0, "淘客订单状态，12-付款，13-关闭，14-确认收货，3-结算成功")
+	fs.IntVar(&orderScene, "order-scene", 0, "订单场景类型，1：常规订单，2：渠道订单，3：会员运营订单")
+	fs.IntVar(&req.PageSize, "page-size", 100, "页大小，1~100")
+	fs.Parse(args)
+	if start == "" {
+		return nil, errors.New("orders: -start is required")
+	}
+	startTime, err := time.ParseInLocation(requests.OrderTimeFormat, start, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	endTime := time.Now()
+	if end != "" {
+		if endTime, err = time.ParseInLocation(requests.OrderTimeFormat, end, time.Local); err != nil {
+			return nil, err
+		}
+	}
+	req.QueryType = requests.OrderQueryType(queryType)
+	req.MemberType = requests.OrderMemberType(memberType)
+	req.TkStatus = requests.OrderTkStatus(tkStatus)
+	req.OrderScene = requests.OrderScene(orderScene)
+	ret := []requests.Order{}
+	if err := requests.SyncOrders(clt, req, startTime, endTime, func(orders []requests.Order) error {
+		ret = append(ret, orders...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func runShopConvert(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var (
+		req      requests.GetShopConvertRequest
+		sellerID uint64
+	)
+	fs := flag.NewFlagSet("shop-convert", flag.ExitOnError)
+	fs.Uint64Var(&sellerID, "seller-id", 0, "店铺ID")
+	fs.StringVar(&req.Pid, "pid", cfg.Pid, "推广位ID")
+	fs.StringVar(&req.RelationID, "relation-id", "", "渠道关系ID")
+	fs.StringVar(&req.ExternalID, "external-id", "", "淘宝客外部用户标记")
+	fs.StringVar(&req.ShopName, "shop-name", "", "店铺名称")
+	fs.Parse(args)
+	if sellerID == 0 && fs.NArg() > 0 {
+		id, err := strconv.ParseUint(fs.Arg(0), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("shop-convert: invalid seller id %q", fs.Arg(0))
+		}
+		sellerID = id
+	}
+	if sellerID == 0 {
+		return nil, errors.New("shop-convert: -seller-id is required")
+	}
+	req.SellerID = util.Uint64(sellerID)
+	ret := new(requests.ShopConvert)
+	if err := requests.GetShopConvert(clt, &req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func runTwdToTwd(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var req requests.TwdToTwdRequest
+	fs := flag.NewFlagSet("twd-to-twd", flag.ExitOnError)
+	fs.StringVar(&req.Content, "content", "", "淘口令")
+	fs.StringVar(&req.Pid, "pid", cfg.Pid, "推广位ID")
+	fs.StringVar(&req.ChannelID, "channel-id", "", "渠道id")
+	fs.StringVar(&req.Special, "special", "", "会员运营ID")
+	fs.StringVar(&req.External, "external", "", "淘宝客外部用户标记")
+	fs.StringVar(&req.AuthID, "auth-id", "", "平台的淘宝授权id")
+	fs.Parse(args)
+	if req.Content == "" {
+		req.Content = strings.Join(fs.Args(), " ")
+	}
+	if req.Content == "" {
+		return nil, errors.New("twd-to-twd: -content is required")
+	}
+	ret := new(requests.PrivilegeLink)
+	if err := requests.TwdToTwd(clt, &req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func runTaokouling(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var req requests.CreatTaokoulingRequest
+	fs := flag.NewFlagSet("taokouling", flag.ExitOnError)
+	fs.StringVar(&req.Text, "text", "", "口令弹框内容，长度大于5个字符")
+	fs.StringVar(&req.URL, "url", "", "口令跳转目标页，必须以https开头")
+	fs.StringVar(&req.Logo, "logo", "", "口令弹框logoURL")
+	fs.StringVar(&req.UserID, "user-id", "", "生成口令的淘宝用户ID")
+	fs.Parse(args)
+	if req.Text == "" || req.URL == "" {
+		return nil, errors.New("taokouling: -text and -url are required")
+	}
+	ret := new(requests.Taokouling)
+	if err := requests.CreatTaokouling(clt, &req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func runTlj(clt *core.Client, cfg *Config, args []string) (interface{}, error) {
+	var (
+		req                requests.CreateTljRequest
+		sendStart, sendEnd string
+		useStart, useEnd   string
+	)
+	fs := flag.NewFlagSet("tlj", flag.ExitOnError)
+	req.AlimamaAppKey = cfg.AlimamaAppKey
+	req.AlimamaAppSecret = cfg.AlimamaAppSecret
+	fs.StringVar(&req.Name, "name", "", "淘礼金名称，最大10个字符")
+	fs.StringVar(&req.ItemID, "item-id", "", "宝贝id")
+	fs.Float64Var(&req.PerFace, "per-face", 0, "单个淘礼金面额，单位元")
+	fs.IntVar(&req.TotalNum, "total-num", 0, "淘礼金总个数")
+	fs.IntVar(&req.WinNumLimit, "win-num-limit", 1, "单用户累计中奖次数上限")
+	fs.StringVar(&sendStart, "send-start", "", "发放开始时间，格式："+requests.TljTimeFormat)
+	fs.StringVar(&sendEnd, "send-end", "", "发放截止时间，格式："+requests.TljTimeFormat)
+	fs.IntVar(&req.UseDays, "use-days", 0, "相对时间模式下的使用期限，1~7天")
+	fs.StringVar(&useStart, "use-start", "", "绝对时间模式下的使用开始日期，格式："+requests.TljDateFormat)
+	fs.StringVar(&useEnd, "use-end", "", "绝对时间模式下的使用结束日期，格式："+requests.TljDateFormat)
+	fs.StringVar(&req.CampaignType, "campaign-type", "", "CPS佣金计划类型，DX、LINK_EVENT或MKT")
+	fs.Parse(args)
+	var err error
+	if req.SendStartTime, err = parseLocalTime(requests.TljTimeFormat, sendStart); err != nil {
+		return nil, err
+	}
+	if req.SendEndTime, err = parseLocalTime(requests.TljTimeFormat, sendEnd); err != nil {
+		return nil, err
+	}
+	if useStart != "" || useEnd != "" {
+		req.UseEndTimeMode = requests.TljUseEndTimeModeAbsolute
+		if req.UseStartTime, err = parseLocalTime(requests.TljDateFormat, useStart); err != nil {
+			return nil, err
+		}
+		if req.UseEndTime, err = parseLocalTime(requests.TljDateFormat, useEnd); err != nil {
+			return nil, err
+		}
+	} else {
+		req.UseEndTimeMode = requests.TljUseEndTimeModeRelative
+	}
+	ret := new(requests.Tlj)
+	if err := requests.CreateTlj(clt, &req, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func parseLocalTime(layout string, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.ParseInLocation(layout, value, time.Local)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+const usage = `Usage: dataoke [-config file] [-format json|table|csv] [-debug] <command> [flags]
+
+Commands:
+  detail         单品详情 (GetGoodsDetails)
+  search         联盟搜索 (GetTbService)
+  convert        高效转链 (GetPrivilageLink)
+  parse          淘系万能解析 (ParseContent)
+  orders         订单查询，自动切分3小时窗口并翻页 (GetOrderDetails)
+  shop-convert   店铺转链 (GetShopConvert)
+  twd-to-twd     淘口令转淘口令 (TwdToTwd)
+  taokouling     淘口令生成 (CreatTaokouling)
+  tlj            淘礼金创建 (CreateTlj)
+  call           调用任意接口：call [-post] <path> key=value ...
+
+appKey/appSecret are read from DATAOKE_APP_KEY/DATAOKE_APP_SECRET or the config file.
+tlj reads alimamaAppKey/alimamaAppSecret from ALIMAMA_APP_KEY/ALIMAMA_APP_SECRET or the config file.
+Run "dataoke <command> -h" for command flags.
+`
+
+// Config 命令行配置
+type Config struct {
+	// AppKey 大淘客appKey
+	AppKey string `json:"appKey,omitempty"`
+	// AppSecret 大淘客appSecret
+	AppSecret string `json:"appSecret,omitempty"`
+	// Version API版本
+	Version string `json:"version,omitempty"`
+	// Pid 默认推广位ID
+	Pid string `json:"pid,omitempty"`
+	// AlimamaAppKey 阿里妈妈appKey，淘礼金创建使用
+	AlimamaAppKey string `json:"alimamaAppKey,omitempty"`
+	// AlimamaAppSecret 阿里妈妈appSecret，淘礼金创建使用
+	AlimamaAppSecret string `json:"alimamaAppSecret,omitempty"`
+}
+
+type command struct {
+	name string
+	run  func(clt *core.Client, cfg *Config, args []string) (interface{}, error)
+}
+
+var commands = []command{
+	{name: "detail", run: runDetail},
+	{name: "search", run: runSearch},
+	{name: "convert", run: runConvert},
+	{name: "parse", run: runParse},
+	{name: "orders", run: runOrders},
+	{name: "shop-convert", run: runShopConvert},
+	{name: "twd-to-twd", run: runTwdToTwd},
+	{name: "taokouling", run: runTaokouling},
+	{name: "tlj", run: runTlj},
+	{name: "call", run: runCall},
+}
+
+func main() {
+	var (
+		configFile string
+		format     string
+		debug      bool
+	)
+	flag.StringVar(&configFile, "config", os.Getenv("DATAOKE_CONFIG"), "config file (json)")
+	flag.StringVar(&format, "format", "json", "output format: json, table or csv")
+	flag.BoolVar(&debug, "debug", false, "log requests and responses")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fatal(err)
+	}
+	clt := core.NewClient(cfg.AppKey, cfg.AppSecret)
+	if cfg.Version != "" {
+		clt.SetVersion(cfg.Version)
+	}
+	if debug {
+		clt.SetDebug(true)
+	}
+	name := flag.Arg(0)
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		ret, err := cmd.run(clt, cfg, flag.Args()[1:])
+		if err != nil {
+			fatal(err)
+		}
+		if err := render(os.Stdout, format, ret); err != nil {
+			fatal(err)
+		}
+		return
+	}
+	fmt.Fprintf(os.Stderr, "unknown command: %s\n", name)
+	flag.Usage()
+	os.Exit(2)
+}
+
+func loadConfig(path string) (*Config, error) {
+	cfg := new(Config)
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, cfg); err != nil {
+			return nil, err
+		}
+	}
+	if v := os.Getenv("DATAOKE_APP_KEY"); v != "" {
+		cfg.AppKey = v
+	}
+	if v := os.Getenv("DATAOKE_APP_SECRET"); v != "" {
+		cfg.AppSecret = v
+	}
+	if v := os.Getenv("DATAOKE_VERSION"); v != "" {
+		cfg.Version = v
+	}
+	if v := os.Getenv("DATAOKE_PID"); v != "" {
+		cfg.Pid = v
+	}
+	if v := os.Getenv("ALIMAMA_APP_KEY"); v != "" {
+		cfg.AlimamaAppKey = v
+	}
+	if v := os.Getenv("ALIMAMA_APP_SECRET"); v != "" {
+		cfg.AlimamaAppSecret = v
+	}
+	if cfg.AppKey == "" || cfg.AppSecret == "" {
+		return nil, errors.New("appKey and appSecret are required, set DATAOKE_APP_KEY/DATAOKE_APP_SECRET or use -config")
+	}
+	return cfg, nil
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "dataoke:", err)
+	os.Exit(1)
+}
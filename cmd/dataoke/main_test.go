@@ -0,0 +1,68 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(file, []byte(`{"appKey":"fileKey","appSecret":"fileSecret","pid":"filePid","alimamaAppSecret":"fileAlimama"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		path    string
+		env     map[string]string
+		want    Config
+		wantErr bool
+	}{
+		{
+			name: "file only",
+			path: file,
+			want: Config{AppKey: "fileKey", AppSecret: "fileSecret", Pid: "filePid", AlimamaAppSecret: "fileAlimama"},
+		},
+		{
+			name: "env overrides file",
+			path: file,
+			env:  map[string]string{"DATAOKE_APP_KEY": "envKey", "DATAOKE_PID": "envPid", "DATAOKE_VERSION": "v2", "ALIMAMA_APP_SECRET": "envAlimama"},
+			want: Config{AppKey: "envKey", AppSecret: "fileSecret", Version: "v2", Pid: "envPid", AlimamaAppSecret: "envAlimama"},
+		},
+		{
+			name: "env only",
+			env:  map[string]string{"DATAOKE_APP_KEY": "envKey", "DATAOKE_APP_SECRET": "envSecret"},
+			want: Config{AppKey: "envKey", AppSecret: "envSecret"},
+		},
+		{
+			name:    "missing secret",
+			env:     map[string]string{"DATAOKE_APP_KEY": "envKey"},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			path:    filepath.Join(t.TempDir(), "none.json"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{"DATAOKE_APP_KEY", "DATAOKE_APP_SECRET", "DATAOKE_VERSION", "DATAOKE_PID", "ALIMAMA_APP_KEY", "ALIMAMA_APP_SECRET"} {
+				t.Setenv(k, tt.env[k])
+			}
+			cfg, err := loadConfig(tt.path)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("loadConfig err = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *cfg != tt.want {
+				t.Errorf("loadConfig = %+v, want %+v", *cfg, tt.want)
+			}
+		})
+	}
+}
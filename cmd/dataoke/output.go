@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+)
+
+func render(w io.Writer, format string, v interface{}) error {
+	switch format {
+	case "", "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		return enc.Encode(v)
+	case "table":
+		header, rows, err := flatten(v)
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		writeTabRow(tw, header)
+		for _, row := range rows {
+			writeTabRow(tw, row)
+		}
+		return tw.Flush()
+	case "csv":
+		header, rows, err := flatten(v)
+		if err != nil {
+			return err
+		}
+		cw := csv.NewWriter(w)
+		cw.Write(header)
+		cw.WriteAll(rows)
+		return cw.Error()
+	}
+	return fmt.Errorf("unknown format: %s", format)
+}
+
+func writeTabRow(w io.Writer, row []string) {
+	for i, col := range row {
+		if i > 0 {
+			io.WriteString(w, "\t")
+		}
+		io.WriteString(w, col)
+	}
+	io.WriteString(w, "\n")
+}
+
+// flatten 将结果转换为表格，对象按字段展开为一行，数组每个元素一行，嵌套字段以json输出
+func flatten(v interface{}) ([]string, [][]string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	var items []map[string]json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		var item map[string]json.RawMessage
+		if err := json.Unmarshal(b, &item); err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+	keys := make(map[string]struct{})
+	for _, item := range items {
+		for k := range item {
+			keys[k] = struct{}{}
+		}
+	}
+	header := make([]string, 0, len(keys))
+	for k := range keys {
+		header = append(header, k)
+	}
+	sort.Strings(header)
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		row := make([]string, len(header))
+		for i, k := range header {
+			row[i] = cell(item[k])
+		}
+		rows = append(rows, row)
+	}
+	return header, rows, nil
+}
+
+func cell(raw json.RawMessage) string {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	if raw[0] == '"' {
+		if s, err := strconv.Unquote(string(raw)); err == nil {
+			return s
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			return s
+		}
+	}
+	return string(raw)
+}
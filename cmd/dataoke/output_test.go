@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+type testItem struct {
+	ID    int               `json:"id"`
+	Name  string            `json:"name,omitempty"`
+	Tags  []string          `json:"tags,omitempty"`
+	Attrs map[string]string `json:"attrs,omitempty"`
+}
+
+func TestRender(t *testing.T) {
+	object := testItem{ID: 1, Name: "a,b", Attrs: map[string]string{"k": "v"}}
+	array := []testItem{{ID: 1, Name: "x"}, {ID: 2, Tags: []string{"t1", "t2"}}}
+	tests := []struct {
+		name   string
+		format string
+		v      interface{}
+		want   string
+	}{
+		{
+			name:   "json object",
+			format: "json",
+			v:      object,
+			want:   "{\n  \"id\": 1,\n  \"name\": \"a,b\",\n  \"attrs\": {\n    \"k\": \"v\"\n  }\n}\n",
+		},
+		{
+			name:   "json default",
+			format: "",
+			v:      []int{1, 2},
+			want:   "[\n  1,\n  2\n]\n",
+		},
+		{
+			name:   "table object",
+			format: "table",
+			v:      object,
+			want:   "attrs      id  name\n{\"k\":\"v\"}  1   a,b\n",
+		},
+		{
+			name:   "table array",
+			format: "table",
+			v:      array,
+			want:   "id  name  tags\n1   x     \n2         [\"t1\",\"t2\"]\n",
+		},
+		{
+			name:   "csv object",
+			format: "csv",
+			v:      object,
+			want:   "attrs,id,name\n\"{\"\"k\"\":\"\"v\"\"}\",1,\"a,b\"\n",
+		},
+		{
+			name:   "csv array",
+			format: "csv",
+			v:      array,
+			want:   "id,name,tags\n1,x,\n2,,\"[\"\"t1\"\",\"\"t2\"\"]\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := render(&buf, tt.format, tt.v); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("render = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if err := render(new(bytes.Buffer), "xml", object); err == nil {
+		t.Error("render(xml) err = nil, want unknown format")
+	}
+	if err := render(new(bytes.Buffer), "csv", 1); err == nil {
+		t.Error("render(csv, scalar) err = nil, want error")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	header, rows, err := flatten([]map[string]interface{}{
+		{"a": 1, "b": nil},
+		{"b": "x", "c": map[string]int{"d": 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(header, want) {
+		t.Errorf("header = %v, want %v", header, want)
+	}
+	want := [][]string{{"1", "", ""}, {"", "x", `{"d":2}`}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows = %q, want %q", rows, want)
+	}
+}
+
+func TestCell(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{raw: "", want: ""},
+		{raw: "null", want: ""},
+		{raw: `"text"`, want: "text"},
+		{raw: `"中文"`, want: "中文"},
+		{raw: `"a\/b"`, want: "a/b"},
+		{raw: "1.5", want: "1.5"},
+		{raw: "true", want: "true"},
+		{raw: `[1,2]`, want: "[1,2]"},
+	}
+	for _, tt := range tests {
+		if got := cell([]byte(tt.raw)); got != tt.want {
+			t.Errorf("cell(%s) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 // Client sdk client
 type Client struct {
 	http      *http.Client
+	gateway   string
 	appKey    string
 	appSecret string
 	version   string
@@ -28,6 +29,7 @@ type Client struct {
 func NewClient(appKey string, appSecret string) *Client {
 	return &Client{
 		http:      http.DefaultClient,
+		gateway:   GATEWAY,
 		appKey:    appKey,
 		appSecret: appSecret,
 		version:   VERSION,
@@ -39,6 +41,11 @@ func (c *Client) SetHttpClient(clt *http.Client) {
 	c.http = clt
 }
 
+// SetGateway change api gateway for Client, e.g. a test server
+func (c *Client) SetGateway(gateway string) {
+	c.gateway = gateway
+}
+
 // SetDebug set debug mode for Client
 func (c *Client) SetDebug(debug bool) {
 	c.debug = true
@@ -54,11 +61,11 @@ func (c *Client) Get(req Request, resp interface{}) error {
 	values := util.GetUrlValues()
 	req.Values(values)
 	c.sign(values)
-	gw := util.StringsJoin(GATEWAY, req.Url(), "?", values.Encode())
-	util.PutUrlValues(values)
+	gw := util.StringsJoin(c.gateway, req.Url(), "?", values.Encode())
 	if c.debug {
-		log.Println("[DATAOKE] [GET]: ", gw)
+		log.Println("[DATAOKE] [GET]: ", util.StringsJoin(c.gateway, req.Url(), "?", redactValues(values).Encode()))
 	}
+	util.PutUrlValues(values)
 	httpReq, err := http.NewRequest(http.MethodGet, gw, nil)
 	if err != nil {
 		return err
@@ -72,23 +79,39 @@ func (c *Client) Post(req Request, resp interface{}) error {
 	values := util.GetUrlValues()
 	req.Values(values)
 	c.sign(values)
-	gw := util.StringsJoin(GATEWAY, req.Url())
+	gw := util.StringsJoin(c.gateway, req.Url())
 	httpReq, err := http.NewRequest(http.MethodPost, gw, strings.NewReader(values.Encode()))
 	if c.debug {
 		log.Println("[DATAOKE] [POST]: ", gw)
-		log.Println("[DATAOKE] [PARAMS]: ", gw)
-		for k := range values {
-			log.Printf("[DATAOKE] [PARAMS] %s=%s\n", k, values.Get(k))
+		for k, v := range redactValues(values) {
+			log.Printf("[DATAOKE] [PARAMS] %s=%s\n", k, v[0])
 		}
 	}
 	util.PutUrlValues(values)
 	if err != nil {
 		return err
 	}
-	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	return c.fetch(httpReq, resp)
 }
 
+// secretParams 调试日志中需要隐藏值的参数
+var secretParams = map[string]struct{}{
+	"alimamaAppSecret": {},
+}
+
+// redactValues 返回隐藏secretParams值后的参数副本，用于调试日志
+func redactValues(values url.Values) url.Values {
+	ret := make(url.Values, len(values))
+	for k, v := range values {
+		if _, ok := secretParams[k]; ok {
+			v = []string{"******"}
+		}
+		ret[k] = v
+	}
+	return ret
+}
+
 func (c *Client) fetch(httpReq *http.Request, resp interface{}) error {
 	httpResp, err := c.http.Do(httpReq)
 	if err != nil {
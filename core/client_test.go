@@ -0,0 +1,95 @@
+package core
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+type testRequest struct {
+	values url.Values
+}
+
+func (r testRequest) Values(values url.Values) {
+	for k, vs := range r.values {
+		for _, v := range vs {
+			values.Add(k, v)
+		}
+	}
+}
+
+func (r testRequest) Url() string {
+	return "test/path"
+}
+
+func TestClientPost(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/test/path" {
+			t.Errorf("path = %s, want /test/path", r.URL.Path)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+			t.Errorf("Content-Type = %q, want application/x-www-form-urlencoded", got)
+		}
+		if r.URL.RawQuery != "" {
+			t.Errorf("query = %q, want empty", r.URL.RawQuery)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		for _, k := range []string{"foo", "appKey", "version", "sign"} {
+			if r.PostForm.Get(k) == "" {
+				t.Errorf("form %s is empty", k)
+			}
+		}
+		w.Write([]byte(`{"code":0,"data":{"ok":true}}`))
+	}))
+	defer srv.Close()
+	clt := NewClient("key", "secret")
+	clt.SetGateway(srv.URL + "/")
+	var ret struct {
+		OK bool `json:"ok"`
+	}
+	if err := clt.Post(testRequest{values: url.Values{"foo": {"bar"}}}, &ret); err != nil {
+		t.Fatal(err)
+	}
+	if !ret.OK {
+		t.Error("ok = false, want true")
+	}
+}
+
+func TestClientDebugRedact(t *testing.T) {
+	const secret = "alimama-secret"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"code":0}`))
+	}))
+	defer srv.Close()
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	t.Cleanup(func() {
+		log.SetOutput(os.Stderr)
+	})
+	clt := NewClient("key", "secret")
+	clt.SetGateway(srv.URL + "/")
+	clt.SetDebug(true)
+	req := testRequest{values: url.Values{"alimamaAppSecret": {secret}, "foo": {"bar"}}}
+	for name, call := range map[string]func(Request, interface{}) error{"GET": clt.Get, "POST": clt.Post} {
+		logs.Reset()
+		if err := call(req, nil); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if strings.Contains(logs.String(), secret) {
+			t.Errorf("%s: alimamaAppSecret in debug log:\n%s", name, logs.String())
+		}
+		if !strings.Contains(logs.String(), "bar") {
+			t.Errorf("%s: params missing from debug log:\n%s", name, logs.String())
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package coupon
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrUnrecognized 无法识别的优惠券描述
+var ErrUnrecognized = errors.New("unrecognized coupon text")
+
+// Kind 优惠券类型
+type Kind int
+
+const (
+	// KindUnknown 未知
+	KindUnknown Kind = iota
+	// KindFullReduction 满减，如：满99元减10元
+	KindFullReduction
+	// KindPerFullReduction 每满减，如：每满100减10
+	KindPerFullReduction
+	// KindNoThreshold 无门槛，如：10元无门槛券
+	KindNoThreshold
+	// KindDiscount 折扣，如：满2件打8折
+	KindDiscount
+)
+
+// String implement Stringer interface
+func (k Kind) String() string {
+	switch k {
+	case KindFullReduction:
+		return "满减"
+	case KindPerFullReduction:
+		return "每满减"
+	case KindNoThreshold:
+		return "无门槛"
+	case KindDiscount:
+		return "折扣"
+	}
+	return "未知"
+}
+
+// Coupon 结构化的优惠券信息
+type Coupon struct {
+	// Kind 优惠券类型
+	Kind Kind `json:"kind"`
+	// Threshold 使用门槛，单位元；ByCount为true时为件数
+	Threshold float64 `json:"threshold,omitempty"`
+	// ByCount 门槛是否按件数计算
+	ByCount bool `json:"byCount,omitempty"`
+	// Amount 优惠金额，单位元；折扣券为折扣率，如8折为0.8
+	Amount float64 `json:"amount,omitempty"`
+	// Tiers 多档优惠，按门槛从低到高排列，仅多档时返回
+	Tiers []Coupon `json:"tiers,omitempty"`
+}
+
+// Applicable 按单价和件数判断是否满足至少一档使用门槛
+func (c Coupon) Applicable(price float64, count int) bool {
+	for _, t := range c.tiers() {
+		if t.applicable(price, count) {
+			return true
+		}
+	}
+	return false
+}
+
+// Saving 按单价和件数计算可优惠的金额，多档时取优惠最大的一档
+func (c Coupon) Saving(price float64, count int) float64 {
+	if count < 1 {
+		count = 1
+	}
+	var ret float64
+	for _, t := range c.tiers() {
+		if !t.applicable(price, count) {
+			continue
+		}
+		if v := t.saving(price, count); v > ret {
+			ret = v
+		}
+	}
+	total := price * float64(count)
+	if ret > total {
+		ret = total
+	}
+	return ret
+}
+
+func (c Coupon) tiers() []Coupon {
+	if len(c.Tiers) > 0 {
+		return c.Tiers
+	}
+	return []Coupon{c}
+}
+
+func (c Coupon) applicable(price float64, count int) bool {
+	if c.Kind == KindUnknown {
+		return false
+	}
+	if c.ByCount {
+		return float64(count) >= c.Threshold
+	}
+	return price*float64(count)+1e-9 >= c.Threshold
+}
+
+func (c Coupon) saving(price float64, count int) float64 {
+	total := price * float64(count)
+	switch c.Kind {
+	case KindFullReduction, KindNoThreshold:
+		return c.Amount
+	case KindPerFullReduction:
+		if c.Threshold <= 0 {
+			return c.Amount
+		}
+		base := total
+		if c.ByCount {
+			base = float64(count)
+		}
+		return float64(int(base/c.Threshold+1e-9)) * c.Amount
+	case KindDiscount:
+		if c.Amount > 0 && c.Amount < 1 {
+			return total * (1 - c.Amount)
+		}
+	}
+	return 0
+}
+
+const number = `(\d+(?:\.\d+)?)`
+
+var (
+	fullReductionRegexp = regexp.MustCompile(`(每)?满` + number + `(元|件)?(?:可用|使用)?[,，]?(?:立减|直减|减|优惠|省)` + number + `元?`)
+	discountRegexp      = regexp.MustCompile(`(?:满` + number + `(元|件)?(?:可用|使用)?[,，]?)?(?:打|享|立享)?` + number + `折`)
+	noThresholdRegexp   = regexp.MustCompile(`(?:(?:无门槛|无条件|立减|直减)减?` + number + `元?|` + number + `元(?:无门槛|无条件|优惠)*券)`)
+	thresholdRegexp     = regexp.MustCompile(`满` + number)
+	numberRegexp        = regexp.MustCompile(`^` + number + `$`)
+	fullWidthReplacer   = strings.NewReplacer(
+		"０", "0", "１", "1", "２", "2", "３", "3", "４", "4",
+		"５", "5", "６", "6", "７", "7", "８", "8", "９", "9",
+		"．", ".", "￥", "", "¥", "", " ", "", "　", "", "\t", "",
+	)
+)
+
+// Parse 解析优惠券描述，支持满减、每满减、无门槛、折扣及多档格式，如：满99元减10元、10元无门槛券、满2件打8折、满100减10,满200减25
+func Parse(text string) (Coupon, error) {
+	s := fullWidthReplacer.Replace(strings.TrimSpace(text))
+	if s == "" {
+		return Coupon{}, ErrUnrecognized
+	}
+	var tiers []Coupon
+	for _, m := range fullReductionRegexp.FindAllStringSubmatch(s, -1) {
+		c := Coupon{
+			Kind:      KindFullReduction,
+			Threshold: parseFloat(m[2]),
+			ByCount:   m[3] == "件",
+			Amount:    parseFloat(m[4]),
+		}
+		if m[1] != "" {
+			c.Kind = KindPerFullReduction
+		}
+		if c.Threshold <= 0 && !c.ByCount {
+			c.Kind = KindNoThreshold
+		}
+		tiers = append(tiers, c)
+	}
+	if len(tiers) == 0 {
+		for _, m := range discountRegexp.FindAllStringSubmatch(s, -1) {
+			rate := parseFloat(m[3])
+			if rate <= 0 || rate >= 10 {
+				continue
+			}
+			tiers = append(tiers, Coupon{
+				Kind:      KindDiscount,
+				Threshold: parseFloat(m[1]),
+				ByCount:   m[2] == "件",
+				Amount:    rate / 10,
+			})
+		}
+	}
+	// 有门槛但没有优惠金额时无法识别，避免将门槛误判为无门槛券面额
+	if len(tiers) == 0 && !thresholdRegexp.MatchString(s) {
+		if m := noThresholdRegexp.FindStringSubmatch(s); m != nil {
+			amount := m[1]
+			if amount == "" {
+				amount = m[2]
+			}
+			tiers = append(tiers, Coupon{
+				Kind:   KindNoThreshold,
+				Amount: parseFloat(amount),
+			})
+		}
+	}
+	switch len(tiers) {
+	case 0:
+		return Coupon{}, ErrUnrecognized
+	case 1:
+		return tiers[0], nil
+	}
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].Threshold < tiers[j].Threshold
+	})
+	ret := tiers[0]
+	ret.Tiers = tiers
+	return ret, nil
+}
+
+// ParseConditions 解析单品详情的CouponConditions，该字段通常只有门槛金额（如：39），需结合CouponPrice使用
+func ParseConditions(conditions string, amount float64) (Coupon, error) {
+	s := fullWidthReplacer.Replace(strings.TrimSpace(conditions))
+	if s == "" || numberRegexp.MatchString(s) {
+		c := Coupon{
+			Kind:      KindFullReduction,
+			Threshold: parseFloat(s),
+			Amount:    amount,
+		}
+		if c.Threshold <= 0 {
+			c.Kind = KindNoThreshold
+			c.Threshold = 0
+		}
+		if amount <= 0 {
+			return c, ErrUnrecognized
+		}
+		return c, nil
+	}
+	c, err := Parse(s)
+	if err != nil {
+		return c, err
+	}
+	if c.Amount <= 0 && c.Kind != KindDiscount {
+		c.Amount = amount
+	}
+	return c, nil
+}
+
+func parseFloat(s string) float64 {
+	v, _ := strconv.ParseFloat(s, 64)
+	return v
+}
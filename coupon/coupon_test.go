@@ -0,0 +1,118 @@
+package coupon
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text string
+		want Coupon
+		err  error
+	}{
+		// 满减
+		{text: "满99元减10元", want: Coupon{Kind: KindFullReduction, Threshold: 99, Amount: 10}},
+		{text: "满39减5", want: Coupon{Kind: KindFullReduction, Threshold: 39, Amount: 5}},
+		{text: "满 99.00元 减 10.00元", want: Coupon{Kind: KindFullReduction, Threshold: 99, Amount: 10}},
+		{text: "满200可用,减20", want: Coupon{Kind: KindFullReduction, Threshold: 200, Amount: 20}},
+		{text: "满0元减3元", want: Coupon{Kind: KindNoThreshold, Amount: 3}},
+		// 每满减
+		{text: "每满100减10", want: Coupon{Kind: KindPerFullReduction, Threshold: 100, Amount: 10}},
+		{text: "每满2件减5元", want: Coupon{Kind: KindPerFullReduction, Threshold: 2, ByCount: true, Amount: 5}},
+		// 无门槛
+		{text: "10元无门槛券", want: Coupon{Kind: KindNoThreshold, Amount: 10}},
+		{text: "无门槛减5元", want: Coupon{Kind: KindNoThreshold, Amount: 5}},
+		{text: "立减20元", want: Coupon{Kind: KindNoThreshold, Amount: 20}},
+		{text: "5元优惠券", want: Coupon{Kind: KindNoThreshold, Amount: 5}},
+		{text: "3元券", want: Coupon{Kind: KindNoThreshold, Amount: 3}},
+		// 折扣
+		{text: "满2件打8折", want: Coupon{Kind: KindDiscount, Threshold: 2, ByCount: true, Amount: 0.8}},
+		{text: "8.5折", want: Coupon{Kind: KindDiscount, Amount: 0.85}},
+		{text: "满100元打9折", want: Coupon{Kind: KindDiscount, Threshold: 100, Amount: 0.9}},
+		// 多档
+		{text: "满200减25,满100减10", want: Coupon{
+			Kind: KindFullReduction, Threshold: 100, Amount: 10,
+			Tiers: []Coupon{
+				{Kind: KindFullReduction, Threshold: 100, Amount: 10},
+				{Kind: KindFullReduction, Threshold: 200, Amount: 25},
+			},
+		}},
+		// 全角数字
+		{text: "满１００元减１０元", want: Coupon{Kind: KindFullReduction, Threshold: 100, Amount: 10}},
+		{text: "￥５元无门槛券", want: Coupon{Kind: KindNoThreshold, Amount: 5}},
+		// 无法识别
+		{text: "", err: ErrUnrecognized},
+		{text: "hello", err: ErrUnrecognized},
+		{text: "5元", err: ErrUnrecognized},
+		{text: "满99元可用", err: ErrUnrecognized},
+		{text: "单笔满99元可用", err: ErrUnrecognized},
+		{text: "满99元可用10元券", err: ErrUnrecognized},
+		{text: "10折", err: ErrUnrecognized},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.text)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Parse(%q) error = %v, want %v", tt.text, err, tt.err)
+			continue
+		}
+		if tt.err == nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseConditions(t *testing.T) {
+	tests := []struct {
+		conditions string
+		amount     float64
+		want       Coupon
+		err        error
+	}{
+		{conditions: "39", amount: 5, want: Coupon{Kind: KindFullReduction, Threshold: 39, Amount: 5}},
+		{conditions: "39.0", amount: 5, want: Coupon{Kind: KindFullReduction, Threshold: 39, Amount: 5}},
+		{conditions: "0", amount: 5, want: Coupon{Kind: KindNoThreshold, Amount: 5}},
+		{conditions: "", amount: 5, want: Coupon{Kind: KindNoThreshold, Amount: 5}},
+		{conditions: "满99元减10元", amount: 10, want: Coupon{Kind: KindFullReduction, Threshold: 99, Amount: 10}},
+		{conditions: "39", amount: 0, want: Coupon{Kind: KindFullReduction, Threshold: 39}, err: ErrUnrecognized},
+		{conditions: "满99元可用", amount: 10, err: ErrUnrecognized},
+	}
+	for _, tt := range tests {
+		got, err := ParseConditions(tt.conditions, tt.amount)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("ParseConditions(%q, %v) error = %v, want %v", tt.conditions, tt.amount, err, tt.err)
+			continue
+		}
+		if tt.want.Kind != KindUnknown && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseConditions(%q, %v) = %+v, want %+v", tt.conditions, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestSaving(t *testing.T) {
+	tests := []struct {
+		text  string
+		price float64
+		count int
+		want  float64
+	}{
+		{text: "满99元减10元", price: 120, count: 1, want: 10},
+		{text: "满99元减10元", price: 50, count: 1, want: 0},
+		{text: "满99元减10元", price: 50, count: 2, want: 10},
+		{text: "每满100减10", price: 250, count: 1, want: 20},
+		{text: "满100减10,满200减25", price: 210, count: 1, want: 25},
+		{text: "满2件打8折", price: 50, count: 2, want: 20},
+		{text: "10元无门槛券", price: 6, count: 1, want: 6},
+	}
+	for _, tt := range tests {
+		c, err := Parse(tt.text)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.text, err)
+		}
+		if got := c.Saving(tt.price, tt.count); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Parse(%q).Saving(%v, %d) = %v, want %v", tt.text, tt.price, tt.count, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,738 @@
+package model
+
+import (
+	"strconv"
+
+	"github.com/bububa/dataoke-go/requests"
+)
+
+// Source 商品数据来源
+type Source string
+
+const (
+	// SourceGoodsDetail 单品详情
+	SourceGoodsDetail Source = "goods_detail"
+	// SourceTbkItem 联盟搜索
+	SourceTbkItem Source = "tbk_item"
+	// SourcePrivilegeLink 高效转链
+	SourcePrivilegeLink Source = "privilege_link"
+	// SourceParseContent 淘系万能解析
+	SourceParseContent Source = "parse_content"
+)
+
+// Goods 统一商品模型，金额单位为元，比例为百分比
+type Goods struct {
+	// Source 数据来源
+	Source Source `json:"source,omitempty"`
+	// DataType 淘系万能解析的数据类型，goods标识商品；activity标识活动会场
+	DataType string `json:"dataType,omitempty"`
+	// GoodsID 淘宝商品id
+	GoodsID string `json:"goodsId,omitempty"`
+	// DtkID 大淘客商品id，非大淘客商品为0
+	DtkID int64 `json:"dtkId,omitempty"`
+	// GoodsSign 新商品id
+	GoodsSign string `json:"goodsSign,omitempty"`
+	// Title 商品标题
+	Title string `json:"title,omitempty"`
+	// ShortTitle 短标题
+	ShortTitle string `json:"shortTitle,omitempty"`
+	// Desc 推广文案
+	Desc string `json:"desc,omitempty"`
+	// ItemLink 商品链接
+	ItemLink string `json:"itemLink,omitempty"`
+	// MainPic 商品主图
+	MainPic ImageURL `json:"mainPic,omitempty"`
+	// MarketingMainPic 营销主图
+	MarketingMainPic ImageURL `json:"marketingMainPic,omitempty"`
+	// WhiteImage 白底图
+	WhiteImage ImageURL `json:"whiteImage,omitempty"`
+	// Images 轮播图
+	Images []ImageURL `json:"images,omitempty"`
+	// DetailPics 详情图
+	DetailPics []DetailPic `json:"detailPics,omitempty"`
+	// Video 商品视频
+	Video string `json:"video,omitempty"`
+	// Cid 大淘客一级分类id
+	Cid uint64 `json:"cid,omitempty"`
+	// SubCid 大淘客二级分类id
+	SubCid []uint64 `json:"subCid,omitempty"`
+	// TbCid 淘宝叶子类目id
+	TbCid uint64 `json:"tbCid,omitempty"`
+	// TbCategoryName 淘宝叶子类目名称
+	TbCategoryName string `json:"tbCategoryName,omitempty"`
+	// TbLevelOneCid 淘宝一级类目id
+	TbLevelOneCid uint64 `json:"tbLevelOneCid,omitempty"`
+	// TbLevelOneCategoryName 淘宝一级类目名称
+	TbLevelOneCategoryName string `json:"tbLevelOneCategoryName,omitempty"`
+	// OriginalPrice 原价
+	OriginalPrice float64 `json:"originalPrice,omitempty"`
+	// Price 券前售价
+	Price float64 `json:"price,omitempty"`
+	// ActualPrice 券后价
+	ActualPrice float64 `json:"actualPrice,omitempty"`
+	// Discounts 折扣力度
+	Discounts float64 `json:"discounts,omitempty"`
+	// CommissionType 佣金类型，0-通用，1-定向，2-高佣，3-营销计划
+	CommissionType int `json:"commissionType,omitempty"`
+	// CommissionRate 佣金比例
+	CommissionRate float64 `json:"commissionRate,omitempty"`
+	// MinCommissionRate 预估最低佣金比例
+	MinCommissionRate float64 `json:"minCommissionRate,omitempty"`
+	// Coupon 优惠券信息
+	Coupon *Coupon `json:"coupon,omitempty"`
+	// MonthSales 30天销量
+	MonthSales int64 `json:"monthSales,omitempty"`
+	// DailySales 当天销量
+	DailySales int64 `json:"dailySales,omitempty"`
+	// TwoHoursSales 2小时销量
+	TwoHoursSales int64 `json:"twoHoursSales,omitempty"`
+	// Sales24h 24小时销量
+	Sales24h int64 `json:"sales24h,omitempty"`
+	// TkTotalSales 淘客30天推广量
+	TkTotalSales int64 `json:"tkTotalSales,omitempty"`
+	// Shop 店铺信息
+	Shop Shop `json:"shop,omitempty"`
+	// IsBrand 是否是品牌商品
+	IsBrand int `json:"isBrand,omitempty"`
+	// BrandID 品牌id
+	BrandID uint64 `json:"brandId,omitempty"`
+	// BrandName 品牌名称
+	BrandName string `json:"brandName,omitempty"`
+	// PresaleDeposit 预售定金
+	PresaleDeposit float64 `json:"presaleDeposit,omitempty"`
+	// PresaleDiscount 预售立减金额
+	PresaleDiscount float64 `json:"presaleDiscount,omitempty"`
+	// PresaleDiscountText 预售立减描述
+	PresaleDiscountText string `json:"presaleDiscountText,omitempty"`
+	// EstimateTlj 预估淘礼金
+	EstimateTlj float64 `json:"estimateTlj,omitempty"`
+	// ActivityID 单单有奖活动id
+	ActivityID string `json:"activityId,omitempty"`
+	// BizSceneID 场景ID
+	BizSceneID int `json:"bizSceneId,omitempty"`
+	// SpecialText 特色文案（商品卖点）
+	SpecialText []string `json:"specialText,omitempty"`
+	// RelatedImages 相关商品图
+	RelatedImages []ImageURL `json:"relatedImages,omitempty"`
+	// GoldSellers 是否金牌卖家，1-金牌卖家，0-非金牌卖家
+	GoldSellers int `json:"goldSellers,omitempty"`
+	// HotPush 热推值
+	HotPush int64 `json:"hotPush,omitempty"`
+	// TeamName 放单人名称
+	TeamName string `json:"teamName,omitempty"`
+	// Lowest 是否近30天历史最低价，0-否；1-是
+	Lowest int `json:"lowest,omitempty"`
+	// CreateTime 商品上架时间
+	CreateTime string `json:"createTime,omitempty"`
+	// InspectedGoods 商品是否已经验货，0-否；1-是
+	InspectedGoods int `json:"inspectedGoods,omitempty"`
+	// Subdivision 细分类目信息，商品无细分类目时为nil
+	Subdivision *Subdivision `json:"subdivision,omitempty"`
+	// Yunfeixian 0.不包运费险 1.包运费险
+	Yunfeixian int `json:"yunfeixian,omitempty"`
+	// FreeshipRemoteDistrict 偏远地区包邮，0.不包邮，1.包邮
+	FreeshipRemoteDistrict int `json:"freeshipRemoteDistrict,omitempty"`
+	// RealPostFee 运费
+	RealPostFee float64 `json:"realPostFee,omitempty"`
+	// CpaRewardAmount 单单有奖奖励金额
+	CpaRewardAmount float64 `json:"cpaRewardAmount,omitempty"`
+	// Activity 淘抢购、聚划算等活动信息
+	Activity *Activity `json:"activity,omitempty"`
+	// DirectCommission 定向佣金信息
+	DirectCommission *DirectCommission `json:"directCommission,omitempty"`
+	// Discount 购物津贴、跨店满减信息
+	Discount *Discount `json:"discount,omitempty"`
+	// Links 推广链接
+	Links *Links `json:"links,omitempty"`
+	// Detail 原始单品详情，仅来源为单品详情时存在
+	Detail *requests.GoodsDetail `json:"-"`
+	// TbkItem 原始联盟搜索商品，仅来源为联盟搜索时存在
+	TbkItem *requests.TbkItem `json:"-"`
+	// PrivilegeLink 原始高效转链结果，仅来源为高效转链时存在
+	PrivilegeLink *requests.PrivilegeLink `json:"-"`
+	// ParseContent 原始淘系万能解析结果，仅来源为淘系万能解析时存在
+	ParseContent *requests.ParseContentResult `json:"-"`
+}
+
+// Activity 活动信息
+type Activity struct {
+	// Type 活动类型，1-无活动，2-淘抢购，3-聚划算
+	Type int `json:"type,omitempty"`
+	// StartTime 活动开始时间
+	StartTime string `json:"startTime,omitempty"`
+	// EndTime 活动结束时间
+	EndTime string `json:"endTime,omitempty"`
+	// ID 单品详情ActivityInfo中的活动id
+	ID uint64 `json:"id,omitempty"`
+	// Name 活动名称
+	Name string `json:"name,omitempty"`
+	// PageID 活动会场id，仅淘系万能解析识别为活动会场时存在
+	PageID string `json:"pageId,omitempty"`
+}
+
+// Subdivision 细分类目信息
+type Subdivision struct {
+	// ID 细分类目id
+	ID uint64 `json:"id,omitempty"`
+	// Name 细分类目名称
+	Name string `json:"name,omitempty"`
+	// Rank 商品在细分类目中的排名
+	Rank int `json:"rank,omitempty"`
+}
+
+// DirectCommission 定向佣金信息
+type DirectCommission struct {
+	// Type 定向佣金类型，1非定向，3定向佣金
+	Type int `json:"type,omitempty"`
+	// Rate 定向佣金比例
+	Rate float64 `json:"rate,omitempty"`
+	// Link 定向链接
+	Link string `json:"link,omitempty"`
+}
+
+// Discount 购物津贴、跨店满减信息
+type Discount struct {
+	// Type 1.购物津贴；2.跨店满减；0.无
+	Type int `json:"type,omitempty"`
+	// Full 满减的满值
+	Full float64 `json:"full,omitempty"`
+	// Cut 满减的减值
+	Cut float64 `json:"cut,omitempty"`
+}
+
+// Coupon 统一优惠券信息
+type Coupon struct {
+	// ID 优惠券id
+	ID string `json:"id,omitempty"`
+	// Info 优惠券描述，如：满99元减10元
+	Info string `json:"info,omitempty"`
+	// Amount 优惠券金额
+	Amount float64 `json:"amount,omitempty"`
+	// StartFee 使用门槛
+	StartFee float64 `json:"startFee,omitempty"`
+	// Link 优惠券链接
+	Link string `json:"link,omitempty"`
+	// StartTime 开始时间
+	StartTime string `json:"startTime,omitempty"`
+	// EndTime 结束时间
+	EndTime string `json:"endTime,omitempty"`
+	// TotalNum 总量
+	TotalNum int64 `json:"totalNum,omitempty"`
+	// RemainNum 剩余量
+	RemainNum int64 `json:"remainNum,omitempty"`
+	// ReceiveNum 领取量
+	ReceiveNum int64 `json:"receiveNum,omitempty"`
+	// Status 券状态。0:可用; 非0:不可用
+	Status int `json:"status,omitempty"`
+	// SrcScene 优惠券类型，0-全网公开券；1-阿里妈妈券
+	SrcScene int `json:"srcScene,omitempty"`
+}
+
+// Shop 统一店铺信息
+type Shop struct {
+	// SellerID 卖家id
+	SellerID uint64 `json:"sellerId,omitempty"`
+	// Name 店铺名称
+	Name string `json:"name,omitempty"`
+	// Nick 卖家昵称
+	Nick string `json:"nick,omitempty"`
+	// Logo 店铺logo
+	Logo string `json:"logo,omitempty"`
+	// Type 店铺类型，1-天猫，0-淘宝
+	Type int `json:"type"`
+	// Level 店铺等级
+	Level int `json:"level,omitempty"`
+	// Dsr 店铺dsr评分
+	Dsr float64 `json:"dsr,omitempty"`
+	// DescScore 描述分
+	DescScore float64 `json:"descScore,omitempty"`
+	// DsrPercent 描述同行比
+	DsrPercent float64 `json:"dsrPercent,omitempty"`
+	// ShipScore 物流服务
+	ShipScore float64 `json:"shipScore,omitempty"`
+	// ShipPercent 物流同行比
+	ShipPercent float64 `json:"shipPercent,omitempty"`
+	// ServiceScore 服务态度
+	ServiceScore float64 `json:"serviceScore,omitempty"`
+	// ServicePercent 服务同行比
+	ServicePercent float64 `json:"servicePercent,omitempty"`
+	// Province 所在地
+	Province string `json:"province,omitempty"`
+}
+
+// Links 推广链接
+type Links struct {
+	// Tpwd 淘口令
+	Tpwd string `json:"tpwd,omitempty"`
+	// LongTpwd 长淘口令
+	LongTpwd string `json:"longTpwd,omitempty"`
+	// ShortURL 短链接
+	ShortURL string `json:"shortUrl,omitempty"`
+	// ItemURL 商品淘客链接
+	ItemURL string `json:"itemUrl,omitempty"`
+	// ClickURL 商品s.click推广链接
+	ClickURL string `json:"clickUrl,omitempty"`
+	// CouponClickURL 商品优惠券推广链接
+	CouponClickURL string `json:"couponClickUrl,omitempty"`
+	// KuaiZhanURL 快站链接
+	KuaiZhanURL string `json:"kuaiZhanUrl,omitempty"`
+	// OriginURL 解析的原始链接
+	OriginURL string `json:"originUrl,omitempty"`
+	// OriginType 原始链接中的信息类型
+	OriginType string `json:"originType,omitempty"`
+	// Pid 原始链接中的推广位
+	Pid string `json:"pid,omitempty"`
+}
+
+// FromGoodsDetail 单品详情转换为统一商品模型
+func FromGoodsDetail(d *requests.GoodsDetail) *Goods {
+	ret := &Goods{
+		Source:           SourceGoodsDetail,
+		GoodsID:          d.GoodsID,
+		DtkID:            d.ID,
+		GoodsSign:        d.GoodsSign,
+		Title:            d.Title,
+		ShortTitle:       d.Dtitle,
+		Desc:             d.Desc,
+		ItemLink:         d.ItemLink,
+		MainPic:          NewImageURL(d.MainPic),
+		MarketingMainPic: NewImageURL(d.MarketingMainPic),
+		Images:           ParseImages(d.Imgs),
+		DetailPics:       ParseDetailPics(d.DetailPics),
+		Video:            d.Video,
+		Cid:              d.Cid,
+		SubCid:           d.SubCid,
+		TbCid:            d.TbCid,
+		OriginalPrice:    d.OriginalPrice,
+		Price:            d.ActualPrice + d.CouponPrice,
+		ActualPrice:      d.ActualPrice,
+		Discounts:        d.Discounts,
+		CommissionType:   d.CommissionType,
+		CommissionRate:   d.CommissionRate,
+		MonthSales:       d.MonthSales,
+		DailySales:       d.DailySales,
+		TwoHoursSales:    d.TwoHoursSales,
+		Sales24h:         d.Sales24h,
+		Shop: Shop{
+			SellerID:       d.SellerID.Uint64(),
+			Name:           d.ShopName,
+			Logo:           d.ShopLogo,
+			Type:           d.ShopType,
+			Level:          d.ShopLevel,
+			Dsr:            d.DsrScore,
+			DescScore:      d.DescScore,
+			DsrPercent:     d.DsrPercent,
+			ShipScore:      d.ShipScore,
+			ShipPercent:    d.ShipPercent,
+			ServiceScore:   d.ServiceScore,
+			ServicePercent: d.ServicePercent,
+		},
+		IsBrand:                d.Brand,
+		BrandID:                d.BrandID,
+		BrandName:              d.BrandName,
+		PresaleDeposit:         d.QuanMlink,
+		PresaleDiscount:        d.HzQuanOver,
+		EstimateTlj:            d.EstimateAmount,
+		ActivityID:             d.ActivityID,
+		BizSceneID:             d.BizSceneId,
+		SpecialText:            d.SpecialText,
+		RelatedImages:          ParseImages(d.Reimgs),
+		GoldSellers:            d.GoldSellers,
+		HotPush:                d.HotPush,
+		TeamName:               d.TeamName,
+		Lowest:                 d.Lowest,
+		CreateTime:             d.CreateTime,
+		InspectedGoods:         d.InspectedGoods,
+		Yunfeixian:             d.Yunfeixian,
+		FreeshipRemoteDistrict: d.FreesholdRemoteDistrict,
+		Detail:                 d,
+	}
+	if d.ActivityType > 0 || d.ActivityStartTime != "" || d.ActivityEndTime != "" || d.ActivityInfo != nil {
+		ret.Activity = &Activity{
+			Type:      d.ActivityType,
+			StartTime: d.ActivityStartTime,
+			EndTime:   d.ActivityEndTime,
+		}
+		if info := d.ActivityInfo; info != nil {
+			ret.Activity.ID = info.ActivityID
+			ret.Activity.Name = info.ActivityName
+		}
+	}
+	if d.IsSubdivision > 0 || d.SubdivisionID > 0 {
+		ret.Subdivision = &Subdivision{
+			ID:   d.SubdivisionID,
+			Name: d.SubdivisionName,
+			Rank: d.SubdivisionRank,
+		}
+	}
+	if d.DirectCommissionType > 0 || d.DirectCommission > 1e-15 || d.DirectCommissionLink != "" {
+		ret.DirectCommission = &DirectCommission{
+			Type: d.DirectCommissionType,
+			Rate: d.DirectCommission,
+			Link: d.DirectCommissionLink,
+		}
+	}
+	if d.DiscountType > 0 {
+		ret.Discount = &Discount{
+			Type: d.DiscountType,
+			Full: d.DiscountFull,
+			Cut:  d.DiscountCut,
+		}
+	}
+	if d.CouponPrice > 1e-15 || d.CouponLink != "" {
+		startFee, _ := strconv.ParseFloat(d.CouponConditions, 64)
+		ret.Coupon = &Coupon{
+			ID:         d.CouponID,
+			Amount:     d.CouponPrice,
+			StartFee:   startFee,
+			Link:       d.CouponLink,
+			StartTime:  d.CouponStartTime,
+			EndTime:    d.CouponEndTime,
+			TotalNum:   d.CouponTotalNum,
+			ReceiveNum: d.CouponReceiveNum,
+			RemainNum:  d.CouponTotalNum - d.CouponReceiveNum,
+		}
+		if startFee <= 0 {
+			ret.Coupon.Info = d.CouponConditions
+		}
+	}
+	return ret
+}
+
+// FromTbkItem 联盟搜索商品转换为统一商品模型
+func FromTbkItem(item *requests.TbkItem) *Goods {
+	goodsID := item.ItemID
+	if goodsID == "" {
+		goodsID = item.NumIid
+	}
+	ret := &Goods{
+		Source:                 SourceTbkItem,
+		GoodsID:                goodsID,
+		Title:                  item.Title,
+		ShortTitle:             item.ShortTitle,
+		Desc:                   item.ItemDescription,
+		ItemLink:               item.ItemURL,
+		MainPic:                NewImageURL(item.PictURL),
+		WhiteImage:             NewImageURL(item.WhiteImage),
+		Images:                 NormalizeImages(item.SmallImages.String),
+		TbCid:                  item.CategoryID,
+		TbCategoryName:         item.CategoryName,
+		TbLevelOneCid:          item.LevelOneCategoryID,
+		TbLevelOneCategoryName: item.LevelOneCategoryName,
+		OriginalPrice:          item.ReservePrice.Float64(),
+		Price:                  item.ZkFinalPrice.Float64(),
+		ActualPrice:            item.ZkFinalPrice.Float64(),
+		CommissionRate:         item.CommissionRatePercent(),
+		MonthSales:             item.Volume,
+		TkTotalSales:           item.TkTotalSales.Int64(),
+		Shop: Shop{
+			SellerID: item.SellerID,
+			Name:     item.ShopTitle,
+			Nick:     item.Nick,
+			Type:     item.UserType,
+			Dsr:      float64(item.ShopDsr),
+			Province: item.Province,
+		},
+		PresaleDeposit:      item.PresaleDeposit.Float64(),
+		PresaleDiscountText: item.PresaleDiscountFeeText,
+		EstimateTlj:         item.YsylJltFace.Float64(),
+		ActivityID:          item.ActivityID,
+		RealPostFee:         item.RealPostFee.Float64(),
+		CpaRewardAmount:     item.CpaRewardAmount.Float64(),
+		TbkItem:             item,
+	}
+	if item.URL != "" {
+		ret.Links = &Links{
+			ClickURL: item.URL,
+		}
+	}
+	if item.CouponID != "" || item.CouponInfo != "" || item.CouponAmount > 0 {
+		ret.Coupon = &Coupon{
+			ID:        item.CouponID,
+			Info:      item.CouponInfo,
+			Amount:    float64(item.CouponAmount),
+			StartFee:  item.CouponStartFee.Float64(),
+			StartTime: item.CouponStartTime,
+			EndTime:   item.CouponEndTime,
+			TotalNum:  item.CouponTotalCount,
+			RemainNum: item.CouponRemainCount,
+		}
+		if ret.Coupon.Amount > 0 && (ret.Coupon.StartFee <= 0 || ret.Price >= ret.Coupon.StartFee) {
+			ret.ActualPrice = ret.Price - ret.Coupon.Amount
+		}
+	}
+	return ret
+}
+
+// FromPrivilegeLink 高效转链结果转换为统一商品模型
+func FromPrivilegeLink(link *requests.PrivilegeLink) *Goods {
+	ret := &Goods{
+		Source:            SourcePrivilegeLink,
+		GoodsID:           link.ItemID,
+		OriginalPrice:     link.OriginalPrice.Float64(),
+		ActualPrice:       link.ActualPrice.Float64(),
+		CommissionRate:    link.MaxCommissionRate.Float64(),
+		MinCommissionRate: link.MinCommissionRate.Float64(),
+		Links: &Links{
+			Tpwd:           link.Tpwd,
+			LongTpwd:       link.LongTpwd,
+			ShortURL:       link.ShortURL,
+			ItemURL:        link.ItemURL,
+			CouponClickURL: link.CouponClickURL,
+			KuaiZhanURL:    link.KuaiZhanURL,
+		},
+		PrivilegeLink: link,
+	}
+	if link.CouponInfo != "" || link.CouponClickURL != "" {
+		ret.Coupon = &Coupon{
+			Info:      link.CouponInfo,
+			Link:      link.CouponClickURL,
+			StartTime: link.CouponStartTime,
+			EndTime:   link.CouponEndTime,
+			TotalNum:  link.CouponTotalCount.Int64(),
+			RemainNum: link.CouponRemainCount.Int64(),
+		}
+	}
+	return ret
+}
+
+// FromParseContent 淘系万能解析结果转换为统一商品模型
+func FromParseContent(r *requests.ParseContentResult) *Goods {
+	ret := &Goods{
+		Source:     SourceParseContent,
+		DataType:   r.DataType,
+		GoodsID:    r.GoodsID,
+		Title:      r.ItemName,
+		ItemLink:   r.ItemLink,
+		MainPic:    NewImageURL(r.MainPic),
+		BizSceneID: r.BizSceneID,
+		Links: &Links{
+			OriginURL:  r.OriginURL,
+			OriginType: r.OriginType,
+		},
+		ParseContent: r,
+	}
+	if r.DataType == "activity" {
+		ret.Activity = &Activity{
+			PageID: r.ItemID,
+		}
+	} else if ret.GoodsID == "" {
+		ret.GoodsID = r.ItemID
+	}
+	if info := r.OriginInfo; info != nil {
+		if ret.Title == "" {
+			ret.Title = info.Title
+		}
+		if ret.MainPic == "" {
+			ret.MainPic = NewImageURL(info.Image)
+		}
+		ret.Price = info.Price
+		ret.ActualPrice = info.Price
+		ret.Shop.Name = info.ShopName
+		ret.Shop.Logo = info.ShopLogo
+		ret.Links.Pid = info.Pid
+		if info.Amount > 1e-15 {
+			ret.Coupon = &Coupon{
+				ID:        info.ActitivyID,
+				Amount:    info.Amount,
+				StartFee:  info.StartFee,
+				Link:      r.CouponLink,
+				StartTime: info.StartTime,
+				EndTime:   info.EndTime,
+				Status:    info.Status,
+				SrcScene:  r.CouponSrcScene,
+			}
+			if info.Status == 0 && info.Price >= info.StartFee {
+				ret.ActualPrice = info.Price - info.Amount
+			}
+		}
+	}
+	return ret
+}
+
+// Merge 使用other中的非空字段补全g，用于合并同一商品不同接口的结果
+func (g *Goods) Merge(other *Goods) {
+	if other == nil {
+		return
+	}
+	mergeString(&g.GoodsID, other.GoodsID)
+	mergeString(&g.GoodsSign, other.GoodsSign)
+	mergeString(&g.Title, other.Title)
+	mergeString(&g.ShortTitle, other.ShortTitle)
+	mergeString(&g.Desc, other.Desc)
+	mergeString(&g.ItemLink, other.ItemLink)
+	mergeImage(&g.MainPic, other.MainPic)
+	mergeImage(&g.MarketingMainPic, other.MarketingMainPic)
+	mergeImage(&g.WhiteImage, other.WhiteImage)
+	if len(g.DetailPics) == 0 {
+		g.DetailPics = other.DetailPics
+	}
+	mergeString(&g.Video, other.Video)
+	mergeString(&g.TbCategoryName, other.TbCategoryName)
+	mergeString(&g.TbLevelOneCategoryName, other.TbLevelOneCategoryName)
+	mergeString(&g.BrandName, other.BrandName)
+	mergeString(&g.PresaleDiscountText, other.PresaleDiscountText)
+	mergeString(&g.ActivityID, other.ActivityID)
+	if g.DtkID == 0 {
+		g.DtkID = other.DtkID
+	}
+	if len(g.Images) == 0 {
+		g.Images = other.Images
+	}
+	if g.Cid == 0 {
+		g.Cid = other.Cid
+	}
+	if len(g.SubCid) == 0 {
+		g.SubCid = other.SubCid
+	}
+	if g.TbCid == 0 {
+		g.TbCid = other.TbCid
+	}
+	if g.TbLevelOneCid == 0 {
+		g.TbLevelOneCid = other.TbLevelOneCid
+	}
+	if g.BrandID == 0 {
+		g.BrandID = other.BrandID
+	}
+	mergeFloat(&g.OriginalPrice, other.OriginalPrice)
+	mergeFloat(&g.Price, other.Price)
+	mergeFloat(&g.ActualPrice, other.ActualPrice)
+	mergeFloat(&g.Discounts, other.Discounts)
+	mergeFloat(&g.CommissionRate, other.CommissionRate)
+	mergeFloat(&g.MinCommissionRate, other.MinCommissionRate)
+	mergeFloat(&g.PresaleDeposit, other.PresaleDeposit)
+	mergeFloat(&g.PresaleDiscount, other.PresaleDiscount)
+	mergeFloat(&g.EstimateTlj, other.EstimateTlj)
+	if g.MonthSales == 0 {
+		g.MonthSales = other.MonthSales
+	}
+	if g.TkTotalSales == 0 {
+		g.TkTotalSales = other.TkTotalSales
+	}
+	if g.DailySales == 0 {
+		g.DailySales = other.DailySales
+	}
+	if g.TwoHoursSales == 0 {
+		g.TwoHoursSales = other.TwoHoursSales
+	}
+	if g.Sales24h == 0 {
+		g.Sales24h = other.Sales24h
+	}
+	if g.CommissionType == 0 {
+		g.CommissionType = other.CommissionType
+	}
+	if g.BizSceneID == 0 {
+		g.BizSceneID = other.BizSceneID
+	}
+	if g.IsBrand == 0 {
+		g.IsBrand = other.IsBrand
+	}
+	if g.InspectedGoods == 0 {
+		g.InspectedGoods = other.InspectedGoods
+	}
+	mergeString(&g.DataType, other.DataType)
+	mergeString(&g.CreateTime, other.CreateTime)
+	if g.Shop.SellerID == 0 {
+		g.Shop.SellerID = other.Shop.SellerID
+	}
+	mergeString(&g.Shop.Name, other.Shop.Name)
+	mergeString(&g.Shop.Nick, other.Shop.Nick)
+	mergeString(&g.Shop.Logo, other.Shop.Logo)
+	mergeString(&g.Shop.Province, other.Shop.Province)
+	if g.Shop.Type == 0 {
+		g.Shop.Type = other.Shop.Type
+	}
+	if g.Shop.Level == 0 {
+		g.Shop.Level = other.Shop.Level
+	}
+	mergeFloat(&g.Shop.Dsr, other.Shop.Dsr)
+	mergeFloat(&g.Shop.DescScore, other.Shop.DescScore)
+	mergeFloat(&g.Shop.DsrPercent, other.Shop.DsrPercent)
+	mergeFloat(&g.Shop.ShipScore, other.Shop.ShipScore)
+	mergeFloat(&g.Shop.ShipPercent, other.Shop.ShipPercent)
+	mergeFloat(&g.Shop.ServiceScore, other.Shop.ServiceScore)
+	mergeFloat(&g.Shop.ServicePercent, other.Shop.ServicePercent)
+	mergeString(&g.TeamName, other.TeamName)
+	mergeFloat(&g.RealPostFee, other.RealPostFee)
+	mergeFloat(&g.CpaRewardAmount, other.CpaRewardAmount)
+	if len(g.SpecialText) == 0 {
+		g.SpecialText = other.SpecialText
+	}
+	if len(g.RelatedImages) == 0 {
+		g.RelatedImages = other.RelatedImages
+	}
+	if g.GoldSellers == 0 {
+		g.GoldSellers = other.GoldSellers
+	}
+	if g.HotPush == 0 {
+		g.HotPush = other.HotPush
+	}
+	if g.Lowest == 0 {
+		g.Lowest = other.Lowest
+	}
+	if g.Yunfeixian == 0 {
+		g.Yunfeixian = other.Yunfeixian
+	}
+	if g.FreeshipRemoteDistrict == 0 {
+		g.FreeshipRemoteDistrict = other.FreeshipRemoteDistrict
+	}
+	if g.Activity == nil {
+		g.Activity = other.Activity
+	}
+	if g.DirectCommission == nil {
+		g.DirectCommission = other.DirectCommission
+	}
+	if g.Discount == nil {
+		g.Discount = other.Discount
+	}
+	if g.Subdivision == nil {
+		g.Subdivision = other.Subdivision
+	}
+	if g.Coupon == nil {
+		g.Coupon = other.Coupon
+	}
+	if g.Links == nil {
+		g.Links = other.Links
+	} else if other.Links != nil {
+		mergeString(&g.Links.Tpwd, other.Links.Tpwd)
+		mergeString(&g.Links.LongTpwd, other.Links.LongTpwd)
+		mergeString(&g.Links.ShortURL, other.Links.ShortURL)
+		mergeString(&g.Links.ItemURL, other.Links.ItemURL)
+		mergeString(&g.Links.ClickURL, other.Links.ClickURL)
+		mergeString(&g.Links.CouponClickURL, other.Links.CouponClickURL)
+		mergeString(&g.Links.KuaiZhanURL, other.Links.KuaiZhanURL)
+		mergeString(&g.Links.OriginURL, other.Links.OriginURL)
+		mergeString(&g.Links.OriginType, other.Links.OriginType)
+		mergeString(&g.Links.Pid, other.Links.Pid)
+	}
+	if g.Detail == nil {
+		g.Detail = other.Detail
+	}
+	if g.TbkItem == nil {
+		g.TbkItem = other.TbkItem
+	}
+	if g.PrivilegeLink == nil {
+		g.PrivilegeLink = other.PrivilegeLink
+	}
+	if g.ParseContent == nil {
+		g.ParseContent = other.ParseContent
+	}
+}
+
+func mergeString(dst *string, src string) {
+	if *dst == "" {
+		*dst = src
+	}
+}
+
+func mergeImage(dst *ImageURL, src ImageURL) {
+	if *dst == "" {
+		*dst = src
+	}
+}
+
+func mergeFloat(dst *float64, src float64) {
+	if *dst <= 1e-15 {
+		*dst = src
+	}
+}
@@ -0,0 +1,278 @@
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bububa/dataoke-go/requests"
+)
+
+func TestFromTbkItem(t *testing.T) {
+	tests := []struct {
+		name           string
+		rate           float64
+		commissionRate float64
+	}{
+		{name: "low commission", rate: 90, commissionRate: 0.9},
+		{name: "normal commission", rate: 1550, commissionRate: 15.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := FromTbkItem(&requests.TbkItem{ItemID: "1", CommissionRate: tt.rate})
+			if math.Abs(g.CommissionRate-tt.commissionRate) > 1e-9 {
+				t.Errorf("CommissionRate = %v, want %v", g.CommissionRate, tt.commissionRate)
+			}
+		})
+	}
+}
+
+func TestFromGoodsDetail(t *testing.T) {
+	d := &requests.GoodsDetail{
+		GoodsID:          "1",
+		DescScore:        4.8,
+		GoldSellers:      1,
+		ActivityType:     3,
+		DirectCommission: 20,
+		DiscountType:     2,
+		DiscountFull:     300,
+		DiscountCut:      40,
+		Reimgs:           "//img.alicdn.com/a.jpg,//img.alicdn.com/b.jpg",
+	}
+	g := FromGoodsDetail(d)
+	if g.Shop.DescScore != 4.8 || g.GoldSellers != 1 {
+		t.Errorf("shop fields not mapped: %+v", g.Shop)
+	}
+	if g.Activity == nil || g.Activity.Type != 3 {
+		t.Errorf("Activity = %+v", g.Activity)
+	}
+	if g.DirectCommission == nil || g.DirectCommission.Rate != 20 {
+		t.Errorf("DirectCommission = %+v", g.DirectCommission)
+	}
+	if g.Discount == nil || g.Discount.Full != 300 || g.Discount.Cut != 40 {
+		t.Errorf("Discount = %+v", g.Discount)
+	}
+	if len(g.RelatedImages) != 2 {
+		t.Errorf("RelatedImages = %v", g.RelatedImages)
+	}
+	bs, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(bs), `"detail"`) {
+		t.Errorf("raw detail serialized: %s", bs)
+	}
+}
+
+func TestFromParseContentActivity(t *testing.T) {
+	g := FromParseContent(&requests.ParseContentResult{DataType: "activity", ItemID: "123"})
+	if g.GoodsID != "" {
+		t.Errorf("GoodsID = %q, want empty", g.GoodsID)
+	}
+	if g.Activity == nil || g.Activity.PageID != "123" {
+		t.Errorf("Activity = %+v, want PageID 123", g.Activity)
+	}
+}
+
+func TestFromTbkItemLinks(t *testing.T) {
+	g := FromTbkItem(&requests.TbkItem{ItemID: "1", CouponInfo: "满10元减5元", CouponAmount: 5, URL: "https://s.click.taobao.com/t?e=x"})
+	if g.Coupon == nil || g.Coupon.Link != "" {
+		t.Errorf("Coupon = %+v, want empty link", g.Coupon)
+	}
+	if g.Links == nil || g.Links.ClickURL != "https://s.click.taobao.com/t?e=x" {
+		t.Errorf("Links = %+v", g.Links)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	g := FromGoodsDetail(&requests.GoodsDetail{GoodsID: "1"})
+	g.Merge(&Goods{
+		DailySales:     1,
+		TwoHoursSales:  2,
+		Sales24h:       3,
+		CommissionType: 4,
+		BizSceneID:     5,
+		Shop:           Shop{Type: 1, Level: 6, Dsr: 4.9},
+	})
+	if g.DailySales != 1 || g.TwoHoursSales != 2 || g.Sales24h != 3 || g.CommissionType != 4 || g.BizSceneID != 5 {
+		t.Errorf("Merge = %+v", g)
+	}
+	if g.Shop.Type != 1 || g.Shop.Level != 6 || g.Shop.Dsr != 4.9 {
+		t.Errorf("Merge Shop = %+v", g.Shop)
+	}
+}
+
+// TestLossless 为来源结构体的每个字段填充唯一值，转换后须能在Goods中找到，新增来源字段未映射时测试失败
+func TestLossless(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		convert func(interface{}) *Goods
+		// skip 有意不单独映射的字段及原因
+		skip map[string]string
+		// scale 转换时换算单位的数值字段
+		scale map[string]float64
+	}{
+		{
+			name:    "GoodsDetail",
+			src:     new(requests.GoodsDetail),
+			convert: func(v interface{}) *Goods { return FromGoodsDetail(v.(*requests.GoodsDetail)) },
+			skip: map[string]string{
+				"IsSubdivision": "由Subdivision是否为nil表示",
+			},
+		},
+		{
+			name:    "TbkItem",
+			src:     new(requests.TbkItem),
+			convert: func(v interface{}) *Goods { return FromTbkItem(v.(*requests.TbkItem)) },
+			skip: map[string]string{
+				"NumIid": "与ItemID相同，仅在ItemID为空时使用",
+			},
+			scale: map[string]float64{
+				"CommissionRate": 0.01,
+			},
+		},
+		{
+			name:    "PrivilegeLink",
+			src:     new(requests.PrivilegeLink),
+			convert: func(v interface{}) *Goods { return FromPrivilegeLink(v.(*requests.PrivilegeLink)) },
+		},
+		{
+			name:    "ParseContentResult",
+			src:     new(requests.ParseContentResult),
+			convert: func(v interface{}) *Goods { return FromParseContent(v.(*requests.ParseContentResult)) },
+			skip: map[string]string{
+				"ItemID":           "dataType=goods时与GoodsID相同，activity时见TestFromParseContentActivity",
+				"OriginInfo.Title": "ItemName为空时的兜底",
+				"OriginInfo.Image": "MainPic为空时的兜底",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				n      int
+				leaves []leaf
+			)
+			fill(reflect.ValueOf(tt.src).Elem(), "", &n, &leaves)
+			var (
+				strs []string
+				nums []float64
+			)
+			collect(reflect.ValueOf(tt.convert(tt.src)).Elem(), &strs, &nums)
+			for _, l := range leaves {
+				if _, ok := tt.skip[l.path]; ok {
+					continue
+				}
+				if l.str != "" {
+					if !containsString(strs, l.str) {
+						t.Errorf("%s.%s (%q) is not mapped", tt.name, l.path, l.str)
+					}
+					continue
+				}
+				want := l.num
+				if f, ok := tt.scale[l.path]; ok {
+					want *= f
+				}
+				if !containsNumber(nums, want) {
+					t.Errorf("%s.%s (%v) is not mapped", tt.name, l.path, want)
+				}
+			}
+		})
+	}
+}
+
+type leaf struct {
+	path string
+	str  string
+	num  float64
+}
+
+// fill 为每个字段填充唯一值，字符串使用图片链接格式以便通过图片解析
+func fill(v reflect.Value, path string, n *int, leaves *[]leaf) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Name
+			if path != "" {
+				name = path + "." + name
+			}
+			fill(v.Field(i), name, n, leaves)
+		}
+		return
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem(), path, n, leaves)
+		return
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0), path, n, leaves)
+		return
+	}
+	*n++
+	switch v.Kind() {
+	case reflect.String:
+		s := "v" + strconv.Itoa(*n) + ".jpg"
+		v.SetString("//img.alicdn.com/" + s)
+		*leaves = append(*leaves, leaf{path: path, str: s})
+	case reflect.Int, reflect.Int64:
+		v.SetInt(int64(1000 + *n))
+		*leaves = append(*leaves, leaf{path: path, num: float64(1000 + *n)})
+	case reflect.Uint64:
+		v.SetUint(uint64(1000 + *n))
+		*leaves = append(*leaves, leaf{path: path, num: float64(1000 + *n)})
+	case reflect.Float64:
+		v.SetFloat(float64(*n) + 0.25)
+		*leaves = append(*leaves, leaf{path: path, num: float64(*n) + 0.25})
+	}
+}
+
+// collect 收集Goods中除原始数据外的所有字符串及数值
+func collect(v reflect.Value, strs *[]string, nums *[]float64) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Tag.Get("json") == "-" {
+				continue
+			}
+			collect(v.Field(i), strs, nums)
+		}
+	case reflect.Ptr:
+		if !v.IsNil() {
+			collect(v.Elem(), strs, nums)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			collect(v.Index(i), strs, nums)
+		}
+	case reflect.String:
+		*strs = append(*strs, v.String())
+	case reflect.Int, reflect.Int64:
+		*nums = append(*nums, float64(v.Int()))
+	case reflect.Uint64:
+		*nums = append(*nums, float64(v.Uint()))
+	case reflect.Float64:
+		*nums = append(*nums, v.Float())
+	}
+}
+
+func containsString(strs []string, s string) bool {
+	for _, v := range strs {
+		if strings.Contains(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsNumber(nums []float64, f float64) bool {
+	for _, v := range nums {
+		if math.Abs(v-f) < 1e-9 {
+			return true
+		}
+	}
+	return false
+}
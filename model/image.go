@@ -0,0 +1,136 @@
+package model
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ImageURL 图片链接
+type ImageURL string
+
+var (
+	alicdnSizeRegexp = regexp.MustCompile(`(?i)(\.(?:jpe?g|png|gif|webp))_[^/]*$`)
+	imageURLRegexp   = regexp.MustCompile(`(?i)(?:https?:)?//[^\s"'<>,;]+?\.(?:jpe?g|png|gif|webp)(?:_[^\s"'<>,;]*)?`)
+)
+
+// NewImageURL 规范化图片链接：去除首尾空白，协议相对链接及http链接统一为https
+func NewImageURL(s string) ImageURL {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "":
+		return ""
+	case strings.HasPrefix(s, "//"):
+		s = "https:" + s
+	case strings.HasPrefix(s, "http://"):
+		s = "https://" + s[len("http://"):]
+	case !strings.HasPrefix(s, "https://"):
+		s = "https://" + s
+	}
+	return ImageURL(s)
+}
+
+// String implement Stringer interface
+func (u ImageURL) String() string {
+	return string(u)
+}
+
+// IsAlicdn 是否阿里CDN图片
+func (u ImageURL) IsAlicdn() bool {
+	s := string(u)
+	return strings.Contains(s, ".alicdn.com/") || strings.Contains(s, ".tbcdn.cn/") || strings.Contains(s, ".taobaocdn.com/")
+}
+
+// Original 去除阿里CDN尺寸、质量等后缀，如：xxx.jpg_310x310.jpg返回xxx.jpg
+func (u ImageURL) Original() ImageURL {
+	if !u.IsAlicdn() {
+		return u
+	}
+	return ImageURL(alicdnSizeRegexp.ReplaceAllString(string(u), "$1"))
+}
+
+// Resize 设置阿里CDN图片尺寸后缀，如：Resize(310, 310)返回xxx.jpg_310x310.jpg；非阿里CDN图片原样返回
+func (u ImageURL) Resize(width int, height int) ImageURL {
+	if !u.IsAlicdn() {
+		return u
+	}
+	orig := u.Original()
+	return ImageURL(string(orig) + "_" + strconv.Itoa(width) + "x" + strconv.Itoa(height) + ".jpg")
+}
+
+// ParseImages 解析逗号分隔的图片字段，如单品详情的Imgs、Reimgs
+func ParseImages(s string) []ImageURL {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	ret := make([]ImageURL, 0, len(parts))
+	for _, p := range parts {
+		if u := NewImageURL(p); u != "" {
+			ret = append(ret, u)
+		}
+	}
+	return ret
+}
+
+// NormalizeImages 规范化图片链接列表，如联盟搜索的SmallImages
+func NormalizeImages(urls []string) []ImageURL {
+	if len(urls) == 0 {
+		return nil
+	}
+	ret := make([]ImageURL, 0, len(urls))
+	for _, s := range urls {
+		if u := NewImageURL(s); u != "" {
+			ret = append(ret, u)
+		}
+	}
+	return ret
+}
+
+// DetailPic 商品详情图
+type DetailPic struct {
+	// Img 图片链接
+	Img ImageURL `json:"img,omitempty"`
+	// Remark 备注
+	Remark string `json:"remark,omitempty"`
+}
+
+// ParseDetailPics 解析单品详情的DetailPics，支持JSON数组（[{"img":"//img.alicdn.com/...","remark":""}]）、逗号分隔链接及其他包含图片链接的文本
+func ParseDetailPics(s string) []DetailPic {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	if strings.HasPrefix(s, "[") {
+		var pics []DetailPic
+		if err := json.Unmarshal([]byte(s), &pics); err == nil {
+			ret := pics[:0]
+			for _, p := range pics {
+				if p.Img = NewImageURL(string(p.Img)); p.Img != "" {
+					ret = append(ret, p)
+				}
+			}
+			return ret
+		}
+	}
+	matches := imageURLRegexp.FindAllString(s, -1)
+	ret := make([]DetailPic, 0, len(matches))
+	for _, m := range matches {
+		ret = append(ret, DetailPic{Img: NewImageURL(m)})
+	}
+	return ret
+}
+
+// DetailPicURLs 单品详情图链接
+func DetailPicURLs(s string) []ImageURL {
+	pics := ParseDetailPics(s)
+	if len(pics) == 0 {
+		return nil
+	}
+	ret := make([]ImageURL, 0, len(pics))
+	for _, p := range pics {
+		ret = append(ret, p.Img)
+	}
+	return ret
+}
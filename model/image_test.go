@@ -0,0 +1,147 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewImageURL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ImageURL
+	}{
+		{in: "", want: ""},
+		{in: "  ", want: ""},
+		{in: "//img.alicdn.com/imgextra/i1/1/O1.jpg", want: "https://img.alicdn.com/imgextra/i1/1/O1.jpg"},
+		{in: "http://img.alicdn.com/i2/x.png", want: "https://img.alicdn.com/i2/x.png"},
+		{in: "https://img.alicdn.com/i2/x.png", want: "https://img.alicdn.com/i2/x.png"},
+		{in: " img.alicdn.com/i2/x.png\n", want: "https://img.alicdn.com/i2/x.png"},
+	}
+	for _, tt := range tests {
+		if got := NewImageURL(tt.in); got != tt.want {
+			t.Errorf("NewImageURL(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestImageURLOriginalResize(t *testing.T) {
+	tests := []struct {
+		in       ImageURL
+		original ImageURL
+		resized  ImageURL
+	}{
+		{
+			in:       "https://img.alicdn.com/i1/x.jpg_310x310.jpg",
+			original: "https://img.alicdn.com/i1/x.jpg",
+			resized:  "https://img.alicdn.com/i1/x.jpg_400x400.jpg",
+		},
+		{
+			in:       "https://img.alicdn.com/i1/x.jpg_.webp",
+			original: "https://img.alicdn.com/i1/x.jpg",
+			resized:  "https://img.alicdn.com/i1/x.jpg_400x400.jpg",
+		},
+		{
+			in:       "https://gw.alicdn.com/bao/uploaded/i4/y.png",
+			original: "https://gw.alicdn.com/bao/uploaded/i4/y.png",
+			resized:  "https://gw.alicdn.com/bao/uploaded/i4/y.png_400x400.jpg",
+		},
+		{
+			in:       "https://example.com/z.jpg_310x310.jpg",
+			original: "https://example.com/z.jpg_310x310.jpg",
+			resized:  "https://example.com/z.jpg_310x310.jpg",
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.in.Original(); got != tt.original {
+			t.Errorf("%q.Original() = %q, want %q", tt.in, got, tt.original)
+		}
+		if got := tt.in.Resize(400, 400); got != tt.resized {
+			t.Errorf("%q.Resize(400, 400) = %q, want %q", tt.in, got, tt.resized)
+		}
+	}
+}
+
+func TestParseImages(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []ImageURL
+	}{
+		{in: ""},
+		{in: ",, ,"},
+		{
+			in:   "//img.alicdn.com/a.jpg,,http://img.alicdn.com/b.jpg_310x310.jpg, ",
+			want: []ImageURL{"https://img.alicdn.com/a.jpg", "https://img.alicdn.com/b.jpg_310x310.jpg"},
+		},
+	}
+	for _, tt := range tests {
+		got := ParseImages(tt.in)
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseImages(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeImages(t *testing.T) {
+	got := NormalizeImages([]string{"//img.alicdn.com/a.jpg", "", "https://img.alicdn.com/b.jpg"})
+	want := []ImageURL{"https://img.alicdn.com/a.jpg", "https://img.alicdn.com/b.jpg"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeImages = %q, want %q", got, want)
+	}
+	if got := NormalizeImages(nil); got != nil {
+		t.Errorf("NormalizeImages(nil) = %q, want nil", got)
+	}
+}
+
+func TestParseDetailPics(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []DetailPic
+	}{
+		{name: "empty"},
+		{
+			name: "json",
+			in:   `[{"img":"//img.alicdn.com/imgextra/i1/1/O1.jpg","remark":"尺码"},{"img":"","remark":""},{"img":"http://img.alicdn.com/i2/O2.png"}]`,
+			want: []DetailPic{
+				{Img: "https://img.alicdn.com/imgextra/i1/1/O1.jpg", Remark: "尺码"},
+				{Img: "https://img.alicdn.com/i2/O2.png"},
+			},
+		},
+		{
+			name: "comma joined",
+			in:   "//img.alicdn.com/a.jpg,//img.alicdn.com/b.jpg_.webp",
+			want: []DetailPic{
+				{Img: "https://img.alicdn.com/a.jpg"},
+				{Img: "https://img.alicdn.com/b.jpg_.webp"},
+			},
+		},
+		{
+			name: "html",
+			in:   `<p><img src="//img.alicdn.com/a.jpg"/><img src='https://img.alicdn.com/b.png'></p>`,
+			want: []DetailPic{
+				{Img: "https://img.alicdn.com/a.jpg"},
+				{Img: "https://img.alicdn.com/b.png"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseDetailPics(tt.in)
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDetailPics = %+v, want %+v", got, tt.want)
+			}
+			urls := DetailPicURLs(tt.in)
+			for i, u := range urls {
+				if u != tt.want[i].Img {
+					t.Errorf("DetailPicURLs[%d] = %q, want %q", i, u, tt.want[i].Img)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package parse
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultSymbols 默认识别的淘口令左右符号，仅包含不会出现在普通文本中的货币类符号
+var DefaultSymbols = [][2]string{
+	{"￥", "￥"}, {"¥", "¥"}, {"€", "€"}, {"£", "£"}, {"₤", "₤"},
+	{"₳", "₳"}, {"¢", "¢"}, {"¤", "¤"}, {"₴", "₴"}, {"₰", "₰"}, {"₵", "₵"},
+	{"₲", "₲"}, {"₭", "₭"}, {"₱", "₱"}, {"₩", "₩"}, {"₪", "₪"}, {"₫", "₫"},
+	{"₮", "₮"}, {"₯", "₯"}, {"₣", "₣"}, {"₢", "₢"}, {"₡", "₡"}, {"₠", "₠"},
+	{"₦", "₦"}, {"₥", "₥"}, {"₹", "₹"}, {"₽", "₽"}, {"₿", "₿"},
+}
+
+// AmbiguousSymbols 淘口令也可能使用的符号，但容易与普通文本混淆，需通过AddSymbols手动启用
+var AmbiguousSymbols = [][2]string{
+	{"$", "$"}, {"@", "@"}, {"/", "/"}, {"〢", "〢"}, {"℗", "℗"}, {"©", "©"}, {"®", "®"},
+	{"(", ")"}, {"（", "）"}, {"《", "》"}, {"「", "」"}, {"【", "】"},
+}
+
+const (
+	// urlPathChars 路径部分仅允许ASCII URL字符及百分号编码
+	urlPathChars = `(?:[A-Za-z0-9\-._~:/@&+=]|%[0-9A-Fa-f]{2})`
+	// urlQueryChars 查询部分额外允许未编码的非ASCII字符，遇到空白、标点及符号（含淘口令符号）时截止
+	urlQueryChars = `(?:[A-Za-z0-9\-._~:/?#@&+=]|%[0-9A-Fa-f]{2}|[^\x00-\x7F\s\p{P}\p{S}\p{Z}])`
+)
+
+var (
+	// urlRegexp 淘系链接，域名左侧须为非字母数字边界，右侧边界在Parse中校验
+	urlRegexp = regexp.MustCompile(`(?i)(?:^|[^a-z0-9.\-])((?:https?://)?(?:[a-z0-9-]+\.)*(?:tb\.cn|taobao\.com|tmall\.com|tmall\.hk))((?:/` + urlPathChars + `*)?(?:[?#]` + urlQueryChars + `*)?)`)
+	// anyURLRegexp 任意链接，链接内的内容不识别为淘口令
+	anyURLRegexp = regexp.MustCompile(`(?i)https?://(?:[A-Za-z0-9\-._~:/?#@&+=!$'()*,;\[\]]|%[0-9A-Fa-f]{2})+`)
+	itemIDRegexp = regexp.MustCompile(`^/i(\d+)\.htm`)
+)
+
+// Parser 本地淘口令、淘系链接识别器，并发安全
+type Parser struct {
+	mu      sync.RWMutex
+	symbols [][2]string
+	re      *regexp.Regexp
+}
+
+// NewParser returns a Parser instance with DefaultSymbols
+func NewParser() *Parser {
+	p := new(Parser)
+	p.AddSymbols(DefaultSymbols...)
+	return p
+}
+
+// AddSymbols 增加自定义淘口令左右符号，与高效转链的LeftSymbol/RightSymbol对应
+func (p *Parser) AddSymbols(pairs ...[2]string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, pair := range pairs {
+		if pair[0] == "" || pair[1] == "" || p.hasSymbols(pair) {
+			continue
+		}
+		p.symbols = append(p.symbols, pair)
+	}
+	alts := make([]string, 0, len(p.symbols))
+	for _, pair := range p.symbols {
+		alts = append(alts, regexp.QuoteMeta(pair[0])+`[A-Za-z0-9]{8,14}`+regexp.QuoteMeta(pair[1]))
+	}
+	// 按长度倒序，保证多字符符号优先匹配
+	sort.SliceStable(alts, func(i, j int) bool {
+		return len(alts[i]) > len(alts[j])
+	})
+	p.re = regexp.MustCompile(`(\d*)(` + strings.Join(alts, "|") + `)(/?)`)
+}
+
+// SetSymbols 自定义淘口令左右符号
+func (p *Parser) SetSymbols(left string, right string) {
+	p.AddSymbols([2]string{left, right})
+}
+
+func (p *Parser) hasSymbols(pair [2]string) bool {
+	for _, v := range p.symbols {
+		if v == pair {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse 识别文本中的淘口令及淘系链接，按出现顺序返回
+func (p *Parser) Parse(text string) []Token {
+	var ret []Token
+	for _, loc := range urlRegexp.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[2], loc[5]
+		// 域名右侧须为路径或非域名字符，避免taobao.com.evil.com
+		if loc[4] == loc[5] && end < len(text) && isHostByte(text[end]) {
+			continue
+		}
+		// 去除句末标点
+		for end > start && strings.IndexByte(".:?#", text[end-1]) >= 0 {
+			end--
+		}
+		if token, ok := parseURL(text[start:end]); ok {
+			token.Start = start
+			token.End = end
+			ret = append(ret, token)
+		}
+	}
+	urls := anyURLRegexp.FindAllStringIndex(text, -1)
+	p.mu.RLock()
+	re := p.re
+	symbols := p.symbols
+	p.mu.RUnlock()
+	for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+		long := isLongTpwd(text, loc)
+		start, end := loc[4], loc[5]
+		if long {
+			start, end = loc[2], loc[7]
+		}
+		if overlaps(ret, start, end) || insideAny(urls, start, end) {
+			continue
+		}
+		token := Token{
+			Kind:  KindTpwd,
+			Text:  text[start:end],
+			Start: start,
+			End:   end,
+		}
+		if long {
+			token.Kind = KindLongTpwd
+		}
+		body := text[loc[4]:loc[5]]
+		for _, pair := range symbols {
+			if strings.HasPrefix(body, pair[0]) && strings.HasSuffix(body, pair[1]) && len(body) > len(pair[0])+len(pair[1]) {
+				token.LeftSymbol = pair[0]
+				token.RightSymbol = pair[1]
+				token.Value = body[len(pair[0]) : len(body)-len(pair[1])]
+				break
+			}
+		}
+		ret = append(ret, token)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Start < ret[j].Start
+	})
+	return ret
+}
+
+// isLongTpwd 长口令须同时具备单个数字前缀及结尾/，且数字前不能紧邻字母数字（如价格100￥...￥）
+func isLongTpwd(text string, loc []int) bool {
+	if loc[3] == loc[2] || loc[7] == loc[6] {
+		return false
+	}
+	if loc[2] > 0 {
+		c := text[loc[2]-1]
+		if c == '.' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isHostByte(c byte) bool {
+	return c == '.' || c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func insideAny(locs [][]int, start int, end int) bool {
+	for _, loc := range locs {
+		if start < loc[1] && end > loc[0] {
+			return true
+		}
+	}
+	return false
+}
+
+func overlaps(tokens []Token, start int, end int) bool {
+	for _, t := range tokens {
+		if start < t.End && end > t.Start {
+			return true
+		}
+	}
+	return false
+}
+
+func parseURL(raw string) (Token, bool) {
+	token := Token{
+		Text:  raw,
+		Value: raw,
+	}
+	if !strings.HasPrefix(strings.ToLower(raw), "http") {
+		token.Value = "https://" + raw
+	}
+	u, err := url.Parse(token.Value)
+	if err != nil {
+		return token, false
+	}
+	host := strings.ToLower(u.Hostname())
+	switch {
+	case host == "m.tb.cn" || host == "tb.cn":
+		token.Kind = KindShortURL
+	case host == "s.click.taobao.com":
+		token.Kind = KindClickURL
+	case host == "uland.taobao.com":
+		token.Kind = KindUlandURL
+		token.GoodsID = u.Query().Get("itemId")
+	case host == "item.taobao.com" || host == "detail.tmall.com" || host == "detail.m.tmall.com" || host == "chaoshi.detail.tmall.com" || host == "detail.tmall.hk" || (host == "h5.m.taobao.com" && strings.Contains(u.Path, "detail")):
+		token.Kind = KindItemURL
+		token.GoodsID = u.Query().Get("id")
+	case host == "a.m.taobao.com":
+		token.Kind = KindItemURL
+		if m := itemIDRegexp.FindStringSubmatch(u.Path); m != nil {
+			token.GoodsID = m[1]
+		}
+	default:
+		token.Kind = KindTaobaoURL
+	}
+	return token, true
+}
+
+var defaultParser = NewParser()
+
+// Parse 使用默认符号识别文本中的淘口令及淘系链接
+func Parse(text string) []Token {
+	return defaultParser.Parse(text)
+}
+
+// GoodsIDs 从文本中的商品链接直接提取淘宝商品id，已去重
+func GoodsIDs(text string) []string {
+	var ret []string
+	seen := make(map[string]struct{})
+	for _, t := range Parse(text) {
+		if t.GoodsID == "" {
+			continue
+		}
+		if _, ok := seen[t.GoodsID]; ok {
+			continue
+		}
+		seen[t.GoodsID] = struct{}{}
+		ret = append(ret, t.GoodsID)
+	}
+	return ret
+}
+
+// NeedParseContent 文本中是否存在需要调用淘系万能解析的淘口令或链接
+func NeedParseContent(text string) bool {
+	for _, t := range Parse(text) {
+		if t.NeedParseContent() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package parse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text string
+		want []Token
+	}{
+		{
+			text: "【淘宝】好物 https://m.tb.cn/h.5abcD?tk=xyz ￥AbCdEfGhIjK￥ 复制打开",
+			want: []Token{
+				{Kind: KindShortURL, Text: "https://m.tb.cn/h.5abcD?tk=xyz", Start: 19, End: 49, Value: "https://m.tb.cn/h.5abcD?tk=xyz"},
+				{Kind: KindTpwd, Text: "￥AbCdEfGhIjK￥", Start: 50, End: 67, Value: "AbCdEfGhIjK", LeftSymbol: "￥", RightSymbol: "￥"},
+			},
+		},
+		{
+			text: "https://m.tb.cn/h.5abcD打开",
+			want: []Token{
+				{Kind: KindShortURL, Text: "https://m.tb.cn/h.5abcD", Start: 0, End: 23, Value: "https://m.tb.cn/h.5abcD"},
+			},
+		},
+		{
+			text: "7€AbCdEfGhIjK€/",
+			want: []Token{
+				{Kind: KindLongTpwd, Text: "7€AbCdEfGhIjK€/", Start: 0, End: 19, Value: "AbCdEfGhIjK", LeftSymbol: "€", RightSymbol: "€"},
+			},
+		},
+		{
+			text: "看看detail.tmall.com/item.htm?id=987。",
+			want: []Token{
+				{Kind: KindItemURL, Text: "detail.tmall.com/item.htm?id=987", Start: 6, End: 38, Value: "https://detail.tmall.com/item.htm?id=987", GoodsID: "987"},
+			},
+		},
+		{
+			text: "https://item.taobao.com/item.htm?spm=a1z&id=123456789.",
+			want: []Token{
+				{Kind: KindItemURL, Text: "https://item.taobao.com/item.htm?spm=a1z&id=123456789", Start: 0, End: 53, Value: "https://item.taobao.com/item.htm?spm=a1z&id=123456789", GoodsID: "123456789"},
+			},
+		},
+		{
+			text: "a.m.taobao.com/i55555.htm s.click.taobao.com/t?e=abc uland.taobao.com/coupon/edetail?e=x&itemId=42",
+			want: []Token{
+				{Kind: KindItemURL, Text: "a.m.taobao.com/i55555.htm", Start: 0, End: 25, Value: "https://a.m.taobao.com/i55555.htm", GoodsID: "55555"},
+				{Kind: KindClickURL, Text: "s.click.taobao.com/t?e=abc", Start: 26, End: 52, Value: "https://s.click.taobao.com/t?e=abc"},
+				{Kind: KindUlandURL, Text: "uland.taobao.com/coupon/edetail?e=x&itemId=42", Start: 53, End: 98, Value: "https://uland.taobao.com/coupon/edetail?e=x&itemId=42", GoodsID: "42"},
+			},
+		},
+		{
+			text: "价格100￥AbCdEfGhIjK￥",
+			want: []Token{
+				{Kind: KindTpwd, Text: "￥AbCdEfGhIjK￥", Start: 9, End: 26, Value: "AbCdEfGhIjK", LeftSymbol: "￥", RightSymbol: "￥"},
+			},
+		},
+		{
+			text: "9￥AbCdEfGhIjK￥ 1.5￥AbCdEfGhIjL￥/",
+			want: []Token{
+				{Kind: KindTpwd, Text: "￥AbCdEfGhIjK￥", Start: 1, End: 18, Value: "AbCdEfGhIjK", LeftSymbol: "￥", RightSymbol: "￥"},
+				{Kind: KindTpwd, Text: "￥AbCdEfGhIjL￥", Start: 22, End: 39, Value: "AbCdEfGhIjL", LeftSymbol: "￥", RightSymbol: "￥"},
+			},
+		},
+		{
+			text: "https://detail.tmall.hk/hk/item.htm?id=5",
+			want: []Token{
+				{Kind: KindItemURL, Text: "https://detail.tmall.hk/hk/item.htm?id=5", Start: 0, End: 40, Value: "https://detail.tmall.hk/hk/item.htm?id=5", GoodsID: "5"},
+			},
+		},
+		{
+			text: "https://item.taobao.com/item.htm?id=1&x=中文 看看",
+			want: []Token{
+				{Kind: KindItemURL, Text: "https://item.taobao.com/item.htm?id=1&x=中文", Start: 0, End: 46, Value: "https://item.taobao.com/item.htm?id=1&x=中文", GoodsID: "1"},
+			},
+		},
+		{
+			text: "https://item.taobao.com/item.htm?id=1，￥AbCdEfGhIjK￥",
+			want: []Token{
+				{Kind: KindItemURL, Text: "https://item.taobao.com/item.htm?id=1", Start: 0, End: 37, Value: "https://item.taobao.com/item.htm?id=1", GoodsID: "1"},
+				{Kind: KindTpwd, Text: "￥AbCdEfGhIjK￥", Start: 40, End: 57, Value: "AbCdEfGhIjK", LeftSymbol: "￥", RightSymbol: "￥"},
+			},
+		},
+		// 不应识别
+		{text: "taobao.com.evil.com/x"},
+		{text: "https://tmall.com-evil.net/x"},
+		{text: "plain text"},
+		{text: "visit xxtb.cn now"},
+		{text: "mytaobao.com.fake"},
+		{text: "(iPhone15Pro) $12345678$ @abcdefghij@"},
+		{text: "https://example.com/abcdefgh12/"},
+		{text: "https://example.com/x?t=￥"},
+	}
+	for _, tt := range tests {
+		got := Parse(tt.text)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParserAddSymbols(t *testing.T) {
+	p := NewParser()
+	p.AddSymbols(AmbiguousSymbols...)
+	p.SetSymbols("<<", ">>")
+	tests := []struct {
+		text  string
+		value string
+	}{
+		{text: "$12345678$", value: "12345678"},
+		{text: "(iPhone15Pro)", value: "iPhone15Pro"},
+		{text: "<<AbCdEfGhIjK>>", value: "AbCdEfGhIjK"},
+		{text: "https://example.com/abcdefgh12/"},
+	}
+	for _, tt := range tests {
+		got := p.Parse(tt.text)
+		if tt.value == "" {
+			if len(got) != 0 {
+				t.Errorf("Parse(%q) = %+v, want none", tt.text, got)
+			}
+			continue
+		}
+		if len(got) != 1 || got[0].Value != tt.value || !got[0].Kind.IsTpwd() {
+			t.Errorf("Parse(%q) = %+v, want tpwd %q", tt.text, got, tt.value)
+		}
+	}
+}
+
+func TestGoodsIDs(t *testing.T) {
+	text := "https://item.taobao.com/item.htm?id=1 https://detail.tmall.com/item.htm?id=1 a.m.taobao.com/i2.htm ￥AbCdEfGhIjK￥"
+	if got, want := GoodsIDs(text), []string{"1", "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GoodsIDs = %v, want %v", got, want)
+	}
+	if !NeedParseContent(text) {
+		t.Error("NeedParseContent = false, want true")
+	}
+	if NeedParseContent("https://item.taobao.com/item.htm?id=1") {
+		t.Error("NeedParseContent = true, want false")
+	}
+}
@@ -0,0 +1,79 @@
+package parse
+
+// Kind 本地识别出的内容类型
+type Kind int
+
+const (
+	// KindUnknown 未知
+	KindUnknown Kind = iota
+	// KindTpwd 淘口令，如：￥AbCdEfGhIjK￥
+	KindTpwd
+	// KindLongTpwd 针对iOS14的长口令，如：7￥AbCdEfGhIjK￥/
+	KindLongTpwd
+	// KindShortURL 淘宝短链接，如：https://m.tb.cn/h.xxxx
+	KindShortURL
+	// KindClickURL 淘客推广链接，如：https://s.click.taobao.com/xxxx
+	KindClickURL
+	// KindUlandURL 二合一链接，如：https://uland.taobao.com/coupon/edetail?e=xxx
+	KindUlandURL
+	// KindItemURL 商品链接，如：https://item.taobao.com/item.htm?id=xxx、https://detail.tmall.com/item.htm?id=xxx
+	KindItemURL
+	// KindTaobaoURL 其他淘系链接，如活动会场
+	KindTaobaoURL
+)
+
+// String implement Stringer interface
+func (k Kind) String() string {
+	switch k {
+	case KindTpwd:
+		return "tpwd"
+	case KindLongTpwd:
+		return "long_tpwd"
+	case KindShortURL:
+		return "short_url"
+	case KindClickURL:
+		return "click_url"
+	case KindUlandURL:
+		return "uland_url"
+	case KindItemURL:
+		return "item_url"
+	case KindTaobaoURL:
+		return "taobao_url"
+	}
+	return "unknown"
+}
+
+// IsTpwd 是否淘口令
+func (k Kind) IsTpwd() bool {
+	return k == KindTpwd || k == KindLongTpwd
+}
+
+// IsURL 是否链接
+func (k Kind) IsURL() bool {
+	return k >= KindShortURL
+}
+
+// Token 文本中识别出的淘口令或链接
+type Token struct {
+	// Kind 类型
+	Kind Kind `json:"kind"`
+	// Text 在原文中的完整片段
+	Text string `json:"text"`
+	// Start 片段在原文中的起始字节位置
+	Start int `json:"start"`
+	// End 片段在原文中的结束字节位置（不含）
+	End int `json:"end"`
+	// Value 淘口令为口令主体（不含左右符号），链接为完整URL
+	Value string `json:"value"`
+	// LeftSymbol 淘口令左边符号
+	LeftSymbol string `json:"leftSymbol,omitempty"`
+	// RightSymbol 淘口令右边符号
+	RightSymbol string `json:"rightSymbol,omitempty"`
+	// GoodsID 能从链接直接取到的淘宝商品id
+	GoodsID string `json:"goodsId,omitempty"`
+}
+
+// NeedParseContent 是否需要调用淘系万能解析才能得到商品信息
+func (t Token) NeedParseContent() bool {
+	return t.GoodsID == ""
+}
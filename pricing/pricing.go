@@ -0,0 +1,196 @@
+package pricing
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/coupon"
+	"github.com/bububa/dataoke-go/requests"
+)
+
+// DefaultServiceFeeRate 阿里妈妈技术服务费比例
+const DefaultServiceFeeRate = 0.1
+
+// RebatePolicy 返利策略
+type RebatePolicy struct {
+	// Rate 用户分成比例，0~1，按扣除技术服务费后的佣金计算
+	Rate float64 `json:"rate,omitempty"`
+	// Max 单件返利上限，0为不限制
+	Max float64 `json:"max,omitempty"`
+	// ServiceFeeRate 技术服务费比例，0~1，为0时使用DefaultServiceFeeRate，小于0时不扣除
+	ServiceFeeRate float64 `json:"serviceFeeRate,omitempty"`
+	// Count 购买件数，默认1
+	Count int `json:"count,omitempty"`
+}
+
+func (p RebatePolicy) serviceFeeRate() float64 {
+	switch {
+	case p.ServiceFeeRate < 0:
+		return 0
+	case p.ServiceFeeRate == 0:
+		return DefaultServiceFeeRate
+	}
+	return p.ServiceFeeRate
+}
+
+func (p RebatePolicy) count() int {
+	if p.Count < 1 {
+		return 1
+	}
+	return p.Count
+}
+
+// Breakdown 价格及佣金明细，金额单位为元，保留两位小数
+type Breakdown struct {
+	// OriginalPrice 商品原价
+	OriginalPrice float64 `json:"originalPrice"`
+	// Price 券前售价
+	Price float64 `json:"price"`
+	// Count 购买件数
+	Count int `json:"count"`
+	// Coupon 优惠券信息，无券时为nil
+	Coupon *coupon.Coupon `json:"coupon,omitempty"`
+	// CouponApplicable 是否满足优惠券使用门槛
+	CouponApplicable bool `json:"couponApplicable"`
+	// CouponAmount 优惠券抵扣金额
+	CouponAmount float64 `json:"couponAmount"`
+	// CrossStoreCut 跨店满减抵扣金额
+	CrossStoreCut float64 `json:"crossStoreCut"`
+	// PresaleDeposit 预售定金
+	PresaleDeposit float64 `json:"presaleDeposit"`
+	// PresaleCut 预售付定金立减金额
+	PresaleCut float64 `json:"presaleCut"`
+	// FinalPrice 到手价
+	FinalPrice float64 `json:"finalPrice"`
+	// CommissionRate 佣金比例，百分比，如：20表示20%
+	CommissionRate float64 `json:"commissionRate"`
+	// CommissionType 佣金类型，0-通用，1-定向，2-高佣，3-营销计划
+	CommissionType int `json:"commissionType"`
+	// Commission 预估佣金（扣除技术服务费前）
+	Commission float64 `json:"commission"`
+	// ServiceFee 技术服务费
+	ServiceFee float64 `json:"serviceFee"`
+	// Rebate 用户返利
+	Rebate float64 `json:"rebate"`
+	// Income 扣除技术服务费及用户返利后的收入
+	Income float64 `json:"income"`
+}
+
+// FromGoodsDetail 根据单品详情计算价格及佣金明细
+func FromGoodsDetail(goods *requests.GoodsDetail, policy RebatePolicy) Breakdown {
+	ret := Breakdown{
+		OriginalPrice:  goods.OriginalPrice,
+		Price:          goods.ActualPrice + goods.CouponPrice,
+		Count:          policy.count(),
+		PresaleDeposit: goods.QuanMlink,
+		PresaleCut:     goods.HzQuanOver,
+		CommissionRate: goods.CommissionRate,
+		CommissionType: goods.CommissionType,
+	}
+	// 定向佣金高于通用佣金时按定向佣金计算
+	if goods.DirectCommissionType == 3 && goods.DirectCommission > ret.CommissionRate {
+		ret.CommissionRate = goods.DirectCommission
+		ret.CommissionType = 1
+	}
+	if goods.CouponPrice > 1e-15 {
+		if c, err := coupon.ParseConditions(goods.CouponConditions, goods.CouponPrice); err == nil {
+			ret.Coupon = &c
+		}
+	}
+	// 券后价缺失时按原价计算
+	if goods.ActualPrice <= 0 {
+		ret.Price = goods.OriginalPrice
+	}
+	// 跨店满减
+	if goods.DiscountType == 2 {
+		ret.CrossStoreCut = crossStoreCut(ret.Price*float64(ret.Count), goods.DiscountFull, goods.DiscountCut)
+	}
+	ret.calculate(policy)
+	return ret
+}
+
+var amountRegexp = regexp.MustCompile(`(\d+(?:\.\d+)?)`)
+
+// FromTbkItem 根据联盟搜索商品计算价格及佣金明细；联盟搜索的CommissionRate为万分比，统一换算为百分比
+func FromTbkItem(item *requests.TbkItem, policy RebatePolicy) Breakdown {
+	ret := Breakdown{
+		OriginalPrice:  item.ReservePrice.Float64(),
+		Price:          item.ZkFinalPrice.Float64(),
+		Count:          policy.count(),
+		PresaleDeposit: item.PresaleDeposit.Float64(),
+		CommissionRate: item.CommissionRatePercent(),
+	}
+	if ret.Price <= 0 {
+		ret.Price = ret.OriginalPrice
+	}
+	if ret.OriginalPrice <= 0 {
+		ret.OriginalPrice = ret.Price
+	}
+	if item.CouponInfo != "" {
+		if c, err := coupon.Parse(item.CouponInfo); err == nil {
+			ret.Coupon = &c
+		}
+	}
+	if ret.Coupon == nil && item.CouponAmount > 0 {
+		ret.Coupon = &coupon.Coupon{
+			Kind:      coupon.KindFullReduction,
+			Threshold: item.CouponStartFee.Float64(),
+			Amount:    float64(item.CouponAmount),
+		}
+		if ret.Coupon.Threshold <= 0 {
+			ret.Coupon.Kind = coupon.KindNoThreshold
+		}
+	}
+	if m := amountRegexp.FindString(item.PresaleDiscountFeeText); m != "" {
+		ret.PresaleCut, _ = strconv.ParseFloat(m, 64)
+	}
+	ret.calculate(policy)
+	return ret
+}
+
+func (b *Breakdown) calculate(policy RebatePolicy) {
+	total := b.Price * float64(b.Count)
+	if b.Coupon != nil {
+		b.CouponApplicable = b.Coupon.Applicable(b.Price, b.Count)
+		if b.CouponApplicable {
+			b.CouponAmount = b.Coupon.Saving(b.Price, b.Count)
+		}
+	}
+	final := total - b.CouponAmount - b.CrossStoreCut - b.PresaleCut
+	if final < 0 {
+		final = 0
+	}
+	b.FinalPrice = round(final)
+	b.Commission = round(b.FinalPrice * b.CommissionRate / 100)
+	b.ServiceFee = round(b.Commission * policy.serviceFeeRate())
+	net := b.Commission - b.ServiceFee
+	rebate := net * policy.Rate
+	if policy.Max > 0 && rebate > policy.Max*float64(b.Count) {
+		rebate = policy.Max * float64(b.Count)
+	}
+	if rebate < 0 {
+		rebate = 0
+	}
+	b.Rebate = floor(rebate)
+	b.Income = round(net - b.Rebate)
+	b.CouponAmount = round(b.CouponAmount)
+	b.CrossStoreCut = round(b.CrossStoreCut)
+}
+
+// crossStoreCut 跨店满减按每满计算
+func crossStoreCut(total float64, full float64, cut float64) float64 {
+	if full <= 0 || cut <= 0 {
+		return 0
+	}
+	return math.Floor(total/full+1e-9) * cut
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// floor 返利向下取整到分，避免超发
+func floor(v float64) float64 {
+	return math.Floor(v*100+1e-9) / 100
+}
@@ -0,0 +1,63 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/bububa/dataoke-go/requests"
+)
+
+func TestFromTbkItem(t *testing.T) {
+	tests := []struct {
+		name           string
+		item           requests.TbkItem
+		policy         RebatePolicy
+		commissionRate float64
+		finalPrice     float64
+		commission     float64
+		rebate         float64
+	}{
+		{
+			name:           "low commission",
+			item:           requests.TbkItem{ZkFinalPrice: 100, CommissionRate: 90},
+			policy:         RebatePolicy{Rate: 0.5},
+			commissionRate: 0.9,
+			finalPrice:     100,
+			commission:     0.9,
+			rebate:         0.4,
+		},
+		{
+			name:           "coupon and max rebate",
+			item:           requests.TbkItem{ReservePrice: 200, ZkFinalPrice: 150, CouponInfo: "满149元减20元", CommissionRate: 1550},
+			policy:         RebatePolicy{Rate: 0.5, Max: 5},
+			commissionRate: 15.5,
+			finalPrice:     130,
+			commission:     20.15,
+			rebate:         5,
+		},
+	}
+	for _, tt := range tests {
+		got := FromTbkItem(&tt.item, tt.policy)
+		if got.CommissionRate != tt.commissionRate || got.FinalPrice != tt.finalPrice || got.Commission != tt.commission || got.Rebate != tt.rebate {
+			t.Errorf("%s: got rate=%v final=%v commission=%v rebate=%v, want rate=%v final=%v commission=%v rebate=%v",
+				tt.name, got.CommissionRate, got.FinalPrice, got.Commission, got.Rebate,
+				tt.commissionRate, tt.finalPrice, tt.commission, tt.rebate)
+		}
+	}
+}
+
+func TestFromGoodsDetail(t *testing.T) {
+	goods := requests.GoodsDetail{
+		OriginalPrice:    129,
+		ActualPrice:      99,
+		CouponPrice:      30,
+		CouponConditions: "100",
+		CommissionRate:   20,
+		DiscountType:     2,
+		DiscountFull:     100,
+		DiscountCut:      10,
+	}
+	got := FromGoodsDetail(&goods, RebatePolicy{Rate: 0.5})
+	if got.FinalPrice != 89 || got.CouponAmount != 30 || got.CrossStoreCut != 10 || got.Commission != 17.8 {
+		t.Errorf("got %+v", got)
+	}
+}
@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ActivityCatalogueRequest 热门活动 API Request
+type ActivityCatalogueRequest struct{}
+
+// Values implement Request interface
+func (r ActivityCatalogueRequest) Values(values url.Values) {}
+
+// Url implement Request interface
+func (r ActivityCatalogueRequest) Url() string {
+	return "goods/activity/catalogue"
+}
+
+// Activity 热门活动
+type Activity struct {
+	// ActivityID 活动id，用于活动商品接口
+	ActivityID uint64 `json:"activityId,omitempty"`
+	// ActivityName 活动名称
+	ActivityName string `json:"activityName,omitempty"`
+	// BannerPC PC端活动banner图
+	BannerPC string `json:"bannerPc,omitempty"`
+	// BannerApp APP端活动banner图
+	BannerApp string `json:"bannerApp,omitempty"`
+	// BackgroundColor 活动背景色
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// ActivityStartTime 活动开始时间
+	ActivityStartTime string `json:"activityStartTime,omitempty"`
+	// ActivityEndTime 活动结束时间
+	ActivityEndTime string `json:"activityEndTime,omitempty"`
+	// GoodsLabel 活动商品标签
+	GoodsLabel string `json:"goodsLabel,omitempty"`
+}
+
+// ActivityCatalogue 热门活动
+func ActivityCatalogue(clt *core.Client, ret *[]Activity) error {
+	return clt.Get(ActivityCatalogueRequest{}, ret)
+}
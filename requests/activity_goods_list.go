@@ -0,0 +1,56 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ActivityGoodsListRequest 活动商品 API Request
+type ActivityGoodsListRequest struct {
+	// ActivityID 通过热门活动API获取的活动id
+	ActivityID uint64 `json:"activityId,omitempty"`
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为100，大于100按100处理
+	PageSize int `json:"pageSize,omitempty"`
+	// Cid 大淘客一级分类ID
+	Cid uint64 `json:"cid,omitempty"`
+	// SubCid 大淘客二级分类ID，可通过超级分类API获取，若与cid同时传入，则以subcid为准
+	SubCid uint64 `json:"subcid,omitempty"`
+	// FreeshipRemoteDistrict 偏远地区包邮，1-是，0-非偏远地区，不填默认所有商品
+	FreeshipRemoteDistrict int `json:"freeshipRemoteDistrict,omitempty"`
+}
+
+// Values implement Request interface
+func (r ActivityGoodsListRequest) Values(values url.Values) {
+	values.Set("activityId", strconv.FormatUint(r.ActivityID, 10))
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 100
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.Cid > 0 {
+		values.Set("cid", strconv.FormatUint(r.Cid, 10))
+	}
+	if r.SubCid > 0 {
+		values.Set("subcid", strconv.FormatUint(r.SubCid, 10))
+	}
+	if r.FreeshipRemoteDistrict == 1 {
+		values.Set("freeshipRemoteDistrict", "1")
+	}
+}
+
+// Url implement Request interface
+func (r ActivityGoodsListRequest) Url() string {
+	return "goods/activity/goods-list"
+}
+
+// ActivityGoodsList 活动商品
+func ActivityGoodsList(clt *core.Client, req *ActivityGoodsListRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
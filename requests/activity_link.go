@@ -0,0 +1,82 @@
+package requests
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ErrNotActivity 解析结果不是活动会场
+var ErrNotActivity = errors.New("parse content result is not an activity")
+
+// ActivityLinkRequest 官方活动会场转链 API Request
+type ActivityLinkRequest struct {
+	// PromotionSceneID 联盟官方活动ID，从联盟官方活动页或官方活动会场API获取
+	PromotionSceneID string `json:"promotionSceneId,omitempty"`
+	// Pid 推广位ID，用户可自由填写当前大淘客账号下已授权淘宝账号的任一pid，若未填写，则默认使用创建应用时绑定的pid
+	Pid string `json:"pid,omitempty"`
+	// RelationID 渠道关系ID
+	RelationID string `json:"relationId,omitempty"`
+	// UnionID 自定义输入串，英文和数字组成，长度不能大于12个字符，区分不同的推广渠道
+	UnionID string `json:"unionId,omitempty"`
+}
+
+// Values implement Request interface
+func (r ActivityLinkRequest) Values(values url.Values) {
+	values.Set("promotionSceneId", r.PromotionSceneID)
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+	if r.RelationID != "" {
+		values.Set("relationId", r.RelationID)
+	}
+	if r.UnionID != "" {
+		values.Set("unionId", r.UnionID)
+	}
+}
+
+// Url implement Request interface
+func (r ActivityLinkRequest) Url() string {
+	return "tb-service/activity-link"
+}
+
+// ActivityLink 官方活动会场转链结果
+type ActivityLink struct {
+	// ClickURL 淘客推广长链
+	ClickURL string `json:"click_url,omitempty"`
+	// WxQrcodeURL 活动二维码
+	WxQrcodeURL string `json:"wx_qrcode_url,omitempty"`
+	// ShortClickURL 淘客推广短链
+	ShortClickURL string `json:"short_click_url,omitempty"`
+	// TerminalType 投放平台，1-PC，2-无线
+	TerminalType string `json:"terminal_type,omitempty"`
+	// MaterialOssURL 物料素材下载地址
+	MaterialOssURL string `json:"material_oss_url,omitempty"`
+	// PageName 会场名称
+	PageName string `json:"page_name,omitempty"`
+	// PageStartTime 活动开始时间
+	PageStartTime string `json:"page_start_time,omitempty"`
+	// PageEndTime 活动结束时间
+	PageEndTime string `json:"page_end_time,omitempty"`
+	// WxMiniprogramPath 微信小程序路径
+	WxMiniprogramPath string `json:"wx_miniprogram_path,omitempty"`
+	// Tpwd 淘口令
+	Tpwd string `json:"tpwd,omitempty"`
+	// LongTpwd 长淘口令
+	LongTpwd string `json:"longTpwd,omitempty"`
+}
+
+// GetActivityLink 官方活动会场转链
+func GetActivityLink(clt *core.Client, req *ActivityLinkRequest, ret *ActivityLink) error {
+	return clt.Get(req, ret)
+}
+
+// ConvertParsedActivity 将淘系万能解析得到的活动会场转为推广链接，req中的PromotionSceneID由解析结果的ItemID填充
+func ConvertParsedActivity(clt *core.Client, parsed *ParseContentResult, req ActivityLinkRequest, ret *ActivityLink) error {
+	if parsed.DataType != "activity" || parsed.ItemID == "" {
+		return ErrNotActivity
+	}
+	req.PromotionSceneID = parsed.ItemID
+	return GetActivityLink(clt, &req, ret)
+}
@@ -0,0 +1,35 @@
+package requests
+
+// Brand 品牌信息
+type Brand struct {
+	// BrandID 品牌id
+	BrandID uint64 `json:"brandId,omitempty"`
+	// BrandName 品牌名称
+	BrandName string `json:"brandName,omitempty"`
+	// BrandEnglish 品牌英文名称
+	BrandEnglish string `json:"brandEnglish,omitempty"`
+	// BrandLogo 品牌logo
+	BrandLogo string `json:"brandLogo,omitempty"`
+	// BrandDesc 品牌介绍
+	BrandDesc string `json:"brandDesc,omitempty"`
+	// BrandFeatures 品牌特色
+	BrandFeatures string `json:"brandFeatures,omitempty"`
+	// Label 品牌标签
+	Label []string `json:"label,omitempty"`
+	// Location 品牌发源地
+	Location string `json:"location,omitempty"`
+	// EstablishTime 品牌创立时间
+	EstablishTime string `json:"establishTime,omitempty"`
+	// Cid 品牌所属大淘客一级分类id
+	Cid uint64 `json:"cid,omitempty"`
+	// FansNum 粉丝数
+	FansNum int64 `json:"fansNum,omitempty"`
+	// Sales 近期销量
+	Sales int64 `json:"sales,omitempty"`
+	// MaxDiscount 最高折扣
+	MaxDiscount float64 `json:"maxDiscount,omitempty"`
+	// MaxDiscountAmount 最高优惠金额
+	MaxDiscountAmount float64 `json:"maxDiscountAmount,omitempty"`
+	// GoodsList 品牌推荐商品，仅品牌列表返回
+	GoodsList []GoodsDetail `json:"goodsList,omitempty"`
+}
@@ -0,0 +1,177 @@
+package requests
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// CategoryTree 超级分类内存索引，按一级分类id和二级分类id检索分类信息，并发安全
+type CategoryTree struct {
+	mu         sync.RWMutex
+	categories []SuperCategory
+	cids       map[uint64]int
+	subCids    map[uint64]subCategoryIndex
+	updatedAt  time.Time
+}
+
+type subCategoryIndex struct {
+	parent int
+	child  int
+}
+
+// NewCategoryTree returns a CategoryTree instance built from categories
+func NewCategoryTree(categories []SuperCategory) *CategoryTree {
+	t := new(CategoryTree)
+	t.Reset(categories)
+	return t
+}
+
+// Reset rebuild index with a copy of categories
+func (t *CategoryTree) Reset(categories []SuperCategory) {
+	categories = cloneCategories(categories)
+	cids := make(map[uint64]int, len(categories))
+	subCids := make(map[uint64]subCategoryIndex)
+	for i, c := range categories {
+		cids[c.Cid] = i
+		for j, sc := range c.Subcategories {
+			subCids[sc.SubCid] = subCategoryIndex{parent: i, child: j}
+		}
+	}
+	t.mu.Lock()
+	t.categories = categories
+	t.cids = cids
+	t.subCids = subCids
+	t.updatedAt = time.Now()
+	t.mu.Unlock()
+}
+
+// Refresh 调用超级分类接口刷新索引
+func (t *CategoryTree) Refresh(clt *core.Client) error {
+	var categories []SuperCategory
+	if err := GetSuperCategory(clt, &categories); err != nil {
+		return err
+	}
+	t.Reset(categories)
+	return nil
+}
+
+// RefreshEvery 按interval周期刷新索引，直到stop关闭；刷新失败时保留旧索引并通过onError回调返回错误
+func (t *CategoryTree) RefreshEvery(clt *core.Client, interval time.Duration, stop <-chan struct{}, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := t.Refresh(clt); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// UpdatedAt 最近一次刷新时间
+func (t *CategoryTree) UpdatedAt() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.updatedAt
+}
+
+// Categories 全部一级分类的副本，修改返回值不影响索引
+func (t *CategoryTree) Categories() []SuperCategory {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return cloneCategories(t.categories)
+}
+
+// Category 根据一级分类id获取分类
+func (t *CategoryTree) Category(cid uint64) (SuperCategory, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	idx, ok := t.cids[cid]
+	if !ok {
+		return SuperCategory{}, false
+	}
+	return cloneCategory(t.categories[idx]), true
+}
+
+// SubCategory 根据二级分类id获取分类及其所属一级分类
+func (t *CategoryTree) SubCategory(subCid uint64) (SubCategory, SuperCategory, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	idx, ok := t.subCids[subCid]
+	if !ok {
+		return SubCategory{}, SuperCategory{}, false
+	}
+	parent := cloneCategory(t.categories[idx.parent])
+	return parent.Subcategories[idx.child], parent, true
+}
+
+// CategoryName 一级分类名称，未找到返回空字符串
+func (t *CategoryTree) CategoryName(cid uint64) string {
+	c, _ := t.Category(cid)
+	return c.Cname
+}
+
+// SubCategoryName 二级分类名称，未找到返回空字符串
+func (t *CategoryTree) SubCategoryName(subCid uint64) string {
+	sc, _, _ := t.SubCategory(subCid)
+	return sc.ScName
+}
+
+// Path 分类路径，如：["女装", "T恤"]；subCid为0或不属于cid时只返回一级分类
+func (t *CategoryTree) Path(cid uint64, subCid uint64) []string {
+	ret := make([]string, 0, 2)
+	if c, ok := t.Category(cid); ok {
+		ret = append(ret, c.Cname)
+	}
+	if subCid == 0 {
+		return ret
+	}
+	sc, parent, ok := t.SubCategory(subCid)
+	if !ok || (cid != 0 && parent.Cid != cid) {
+		return ret
+	}
+	if len(ret) == 0 {
+		ret = append(ret, parent.Cname)
+	}
+	return append(ret, sc.ScName)
+}
+
+// GoodsPaths 商品所属分类路径，一个商品可能有多个二级分类
+func (t *CategoryTree) GoodsPaths(goods *GoodsDetail) [][]string {
+	if len(goods.SubCid) == 0 {
+		if path := t.Path(goods.Cid, 0); len(path) > 0 {
+			return [][]string{path}
+		}
+		return nil
+	}
+	ret := make([][]string, 0, len(goods.SubCid))
+	for _, subCid := range goods.SubCid {
+		if path := t.Path(goods.Cid, subCid); len(path) > 0 {
+			ret = append(ret, path)
+		}
+	}
+	return ret
+}
+
+func cloneCategories(categories []SuperCategory) []SuperCategory {
+	if categories == nil {
+		return nil
+	}
+	ret := make([]SuperCategory, len(categories))
+	for i, c := range categories {
+		ret[i] = cloneCategory(c)
+	}
+	return ret
+}
+
+func cloneCategory(c SuperCategory) SuperCategory {
+	if c.Subcategories != nil {
+		c.Subcategories = append([]SubCategory(nil), c.Subcategories...)
+	}
+	return c
+}
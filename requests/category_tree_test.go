@@ -0,0 +1,109 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+var testCategories = []SuperCategory{
+	{Cid: 1, Cname: "女装", Subcategories: []SubCategory{{SubCid: 11, ScName: "T恤"}, {SubCid: 12, ScName: "连衣裙"}}},
+	{Cid: 2, Cname: "母婴", Subcategories: []SubCategory{{SubCid: 21, ScName: "奶粉"}}},
+}
+
+func TestCategoryTreePath(t *testing.T) {
+	tree := NewCategoryTree(testCategories)
+	tests := []struct {
+		name   string
+		cid    uint64
+		subCid uint64
+		want   []string
+	}{
+		{name: "category only", cid: 1, want: []string{"女装"}},
+		{name: "category and sub", cid: 1, subCid: 12, want: []string{"女装", "连衣裙"}},
+		{name: "sub without cid", subCid: 21, want: []string{"母婴", "奶粉"}},
+		{name: "sub of other category", cid: 1, subCid: 21, want: []string{"女装"}},
+		{name: "unknown sub", cid: 2, subCid: 99, want: []string{"母婴"}},
+		{name: "unknown", cid: 9, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tree.Path(tt.cid, tt.subCid); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Path(%d, %d) = %v, want %v", tt.cid, tt.subCid, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCategoryTreeGoodsPaths(t *testing.T) {
+	tree := NewCategoryTree(testCategories)
+	tests := []struct {
+		name  string
+		goods GoodsDetail
+		want  [][]string
+	}{
+		{name: "no sub", goods: GoodsDetail{Cid: 2}, want: [][]string{{"母婴"}}},
+		{name: "multiple subs", goods: GoodsDetail{Cid: 1, SubCid: []uint64{11, 12}}, want: [][]string{{"女装", "T恤"}, {"女装", "连衣裙"}}},
+		{name: "unknown", goods: GoodsDetail{Cid: 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tree.GoodsPaths(&tt.goods); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GoodsPaths = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCategoryTreeCategoriesCopy(t *testing.T) {
+	tree := NewCategoryTree(testCategories)
+	categories := tree.Categories()
+	categories[0].Cname = "changed"
+	categories[0].Subcategories[0].ScName = "changed"
+	if c, _ := tree.Category(1); c.Cname != "女装" || c.Subcategories[0].ScName != "T恤" {
+		t.Errorf("Category(1) = %+v, modified through Categories()", c)
+	}
+	c, _ := tree.Category(1)
+	c.Subcategories[1].ScName = "changed"
+	if name := tree.SubCategoryName(12); name != "连衣裙" {
+		t.Errorf("SubCategoryName(12) = %q, modified through Category()", name)
+	}
+}
+
+func TestCategoryTreeRefresh(t *testing.T) {
+	code := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if code != 0 {
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": code, "msg": "failed"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "data": testCategories[1:]})
+	}))
+	defer srv.Close()
+	clt := core.NewClient("key", "secret")
+	clt.SetGateway(srv.URL + "/")
+
+	tree := NewCategoryTree(testCategories[:1])
+	if err := tree.Refresh(clt); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tree.Path(2, 21), []string{"母婴", "奶粉"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path after Refresh = %v, want %v", got, want)
+	}
+	if _, ok := tree.Category(1); ok {
+		t.Error("Category(1) still present after Refresh")
+	}
+	// 刷新失败时保留旧索引
+	code = -1
+	updatedAt := tree.UpdatedAt()
+	if err := tree.Refresh(clt); err == nil {
+		t.Error("Refresh err = nil, want error")
+	}
+	if tree.CategoryName(2) != "母婴" || !tree.UpdatedAt().Equal(updatedAt) {
+		t.Error("index changed after failed Refresh")
+	}
+}
@@ -0,0 +1,97 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ContentTransformRequest 多平台万能转链 API Request
+type ContentTransformRequest struct {
+	// Content 需要转链的文本，支持淘系、京东、拼多多的链接和淘口令，文本中的链接将被替换为转链后的链接
+	Content string `json:"content,omitempty"`
+	// Pid 淘系推广位ID，用户可自由填写当前大淘客账号下已授权淘宝账号的任一pid，若未填写，则默认使用创建应用时绑定的pid
+	Pid string `json:"pid,omitempty"`
+	// ChannelID 淘系渠道id将会和传入的pid进行验证，验证通过将正常转链，请确认填入的渠道id是正确的 channelId对应联盟的relationId
+	ChannelID string `json:"channelId,omitempty"`
+	// SpecialID 淘系会员运营id
+	SpecialID string `json:"specialId,omitempty"`
+	// ExternalID 淘宝客外部用户标记，如自身系统账户ID；微信ID等
+	ExternalID string `json:"externalId,omitempty"`
+	// JdUnionID 京东联盟ID
+	JdUnionID string `json:"jdUnionId,omitempty"`
+	// JdPositionID 京东推广位ID
+	JdPositionID string `json:"jdPositionId,omitempty"`
+	// PddPid 拼多多推广位ID
+	PddPid string `json:"pddPid,omitempty"`
+	// PddCustomParameters 拼多多自定义参数
+	PddCustomParameters string `json:"pddCustomParameters,omitempty"`
+}
+
+// Values implement Request interface
+func (r ContentTransformRequest) Values(values url.Values) {
+	values.Set("content", r.Content)
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+	if r.ChannelID != "" {
+		values.Set("channelId", r.ChannelID)
+	}
+	if r.SpecialID != "" {
+		values.Set("specialId", r.SpecialID)
+	}
+	if r.ExternalID != "" {
+		values.Set("externalId", r.ExternalID)
+	}
+	if r.JdUnionID != "" {
+		values.Set("jdUnionId", r.JdUnionID)
+	}
+	if r.JdPositionID != "" {
+		values.Set("jdPositionId", r.JdPositionID)
+	}
+	if r.PddPid != "" {
+		values.Set("pddPid", r.PddPid)
+	}
+	if r.PddCustomParameters != "" {
+		values.Set("pddCustomParameters", r.PddCustomParameters)
+	}
+}
+
+// Url implement Request interface
+func (r ContentTransformRequest) Url() string {
+	return "dels/kit/contentTransform"
+}
+
+// ContentTransformResult 多平台万能转链结果
+type ContentTransformResult struct {
+	// Content 替换链接后的文本
+	Content string `json:"content,omitempty"`
+	// LinkList 每个链接的转链结果
+	LinkList []ContentTransformLink `json:"linkList,omitempty"`
+}
+
+// ContentTransformLink 单个链接的转链结果
+type ContentTransformLink struct {
+	// Platform 链接所属平台，tb-淘系，jd-京东，pdd-拼多多
+	Platform string `json:"platform,omitempty"`
+	// OriginURL 原始链接或淘口令
+	OriginURL string `json:"originUrl,omitempty"`
+	// TransformURL 转链后的链接或淘口令，转链失败时为空
+	TransformURL string `json:"transformUrl,omitempty"`
+	// GoodsID 商品id
+	GoodsID string `json:"goodsId,omitempty"`
+	// Status 转链状态，0-成功，非0-失败
+	Status int `json:"status,omitempty"`
+	// Msg 转链失败原因
+	Msg string `json:"msg,omitempty"`
+}
+
+// IsSuccess 是否转链成功
+func (l ContentTransformLink) IsSuccess() bool {
+	return l.Status == 0 && l.TransformURL != ""
+}
+
+// ContentTransform 多平台万能转链
+func ContentTransform(clt *core.Client, req *ContentTransformRequest, ret *ContentTransformResult) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,49 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// CreatTaokoulingRequest 淘口令生成 API Request
+type CreatTaokoulingRequest struct {
+	// Text 口令弹框内容，长度大于5个字符
+	Text string `json:"text,omitempty"`
+	// URL 口令跳转目标页，如：https://uland.taobao.com/，必须以https开头，可以是二合一链接、长链接、短链接等各种淘宝高佣链接；支持渠道备案链接
+	URL string `json:"url,omitempty"`
+	// Logo 口令弹框logoURL
+	Logo string `json:"logo,omitempty"`
+	// UserID 生成口令的淘宝用户ID，非必传参数
+	UserID string `json:"userId,omitempty"`
+}
+
+// Values implement Request interface
+func (r CreatTaokoulingRequest) Values(values url.Values) {
+	values.Set("text", r.Text)
+	values.Set("url", r.URL)
+	if r.Logo != "" {
+		values.Set("logo", r.Logo)
+	}
+	if r.UserID != "" {
+		values.Set("userId", r.UserID)
+	}
+}
+
+// Url implement Request interface
+func (r CreatTaokoulingRequest) Url() string {
+	return "tb-service/creat-taokouling"
+}
+
+// Taokouling 淘口令生成结果
+type Taokouling struct {
+	// Model 淘口令
+	Model string `json:"model,omitempty"`
+	// LongTpwd 针对iOS14版本，增加对应能解析的长口令
+	LongTpwd string `json:"longTpwd,omitempty"`
+}
+
+// CreatTaokouling 淘口令生成
+func CreatTaokouling(clt *core.Client, req *CreatTaokoulingRequest, ret *Taokouling) error {
+	return clt.Get(req, ret)
+}
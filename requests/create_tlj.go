@@ -0,0 +1,181 @@
+package requests
+
+import (
+	"errors"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+const (
+	// TljMinPerFace 淘礼金单个面额下限，单位：元
+	TljMinPerFace = 1
+	// TljMaxPerFace 淘礼金单个面额上限，单位：元
+	TljMaxPerFace = 1000
+	// TljMaxNameLength 淘礼金名称最大字符数
+	TljMaxNameLength = 10
+	// TljMaxUseDays 相对时间模式下使用期限最大天数
+	TljMaxUseDays = 7
+	// TljTimeFormat 淘礼金时间参数格式
+	TljTimeFormat = "2006-01-02 15:04:05"
+	// TljDateFormat 淘礼金绝对时间模式下使用日期格式
+	TljDateFormat = "2006-01-02"
+)
+
+const (
+	// TljUseEndTimeModeRelative 相对时间，使用期限为领取后UseDays天
+	TljUseEndTimeModeRelative = 1
+	// TljUseEndTimeModeAbsolute 绝对时间，使用期限为UseStartTime至UseEndTime
+	TljUseEndTimeModeAbsolute = 2
+)
+
+var (
+	// ErrTljAlimamaApp 缺少阿里妈妈appKey或appSecret
+	ErrTljAlimamaApp = errors.New("tlj: alimamaAppKey and alimamaAppSecret are required")
+	// ErrTljItemID 缺少商品id
+	ErrTljItemID = errors.New("tlj: itemId is required")
+	// ErrTljName 淘礼金名称为空或超过最大长度
+	ErrTljName = errors.New("tlj: name must be 1~10 characters")
+	// ErrTljPerFace 淘礼金面额超出范围或超过两位小数
+	ErrTljPerFace = errors.New("tlj: perFace must be between 1 and 1000 with at most 2 decimals")
+	// ErrTljTotalNum 淘礼金总个数无效
+	ErrTljTotalNum = errors.New("tlj: totalNum must be greater than 0")
+	// ErrTljWinNumLimit 单用户累计中奖次数上限无效
+	ErrTljWinNumLimit = errors.New("tlj: winNumLimit must be between 1 and totalNum")
+	// ErrTljSendTime 发放时间窗口无效
+	ErrTljSendTime = errors.New("tlj: sendEndTime must be after sendStartTime")
+	// ErrTljUseTime 使用时间窗口无效
+	ErrTljUseTime = errors.New("tlj: invalid use time window")
+	// ErrTljEstimateAmount 面额超过商品预估淘礼金
+	ErrTljEstimateAmount = errors.New("tlj: perFace exceeds goods estimateAmount")
+)
+
+// CreateTljRequest 淘礼金创建 API Request
+type CreateTljRequest struct {
+	// AlimamaAppKey 阿里妈妈的appKey
+	AlimamaAppKey string `json:"alimamaAppKey,omitempty"`
+	// AlimamaAppSecret 阿里妈妈的appSecret
+	AlimamaAppSecret string `json:"alimamaAppSecret,omitempty"`
+	// Name 淘礼金名称，最大10个字符
+	Name string `json:"name,omitempty"`
+	// ItemID 宝贝id
+	ItemID string `json:"itemId,omitempty"`
+	// PerFace 单个淘礼金面额，支持两位小数，单位元
+	PerFace float64 `json:"perFace,omitempty"`
+	// TotalNum 淘礼金总个数
+	TotalNum int `json:"totalNum,omitempty"`
+	// WinNumLimit 单用户累计中奖次数上限
+	WinNumLimit int `json:"winNumLimit,omitempty"`
+	// SendStartTime 发放开始时间
+	SendStartTime time.Time `json:"sendStartTime,omitempty"`
+	// SendEndTime 发放截止时间
+	SendEndTime time.Time `json:"sendEndTime,omitempty"`
+	// UseEndTimeMode 结束日期的模式，1:相对时间，2:绝对时间
+	UseEndTimeMode int `json:"useEndTimeMode,omitempty"`
+	// UseDays 相对时间模式下的使用期限，1~7天
+	UseDays int `json:"useDays,omitempty"`
+	// UseStartTime 绝对时间模式下的使用开始日期
+	UseStartTime time.Time `json:"useStartTime,omitempty"`
+	// UseEndTime 绝对时间模式下的使用结束日期
+	UseEndTime time.Time `json:"useEndTime,omitempty"`
+	// CampaignType CPS佣金计划类型，定向：DX；鹊桥：LINK_EVENT；营销：MKT
+	CampaignType string `json:"campaignType,omitempty"`
+}
+
+// Validate 本地校验请求参数，避免无效请求消耗接口配额
+func (r CreateTljRequest) Validate() error {
+	if r.AlimamaAppKey == "" || r.AlimamaAppSecret == "" {
+		return ErrTljAlimamaApp
+	}
+	if r.ItemID == "" {
+		return ErrTljItemID
+	}
+	if n := utf8.RuneCountInString(r.Name); n == 0 || n > TljMaxNameLength {
+		return ErrTljName
+	}
+	if r.PerFace < TljMinPerFace || r.PerFace > TljMaxPerFace || math.Abs(r.PerFace*100-math.Round(r.PerFace*100)) > 1e-6 {
+		return ErrTljPerFace
+	}
+	if r.TotalNum <= 0 {
+		return ErrTljTotalNum
+	}
+	if r.WinNumLimit <= 0 || r.WinNumLimit > r.TotalNum {
+		return ErrTljWinNumLimit
+	}
+	if r.SendStartTime.IsZero() || !r.SendEndTime.After(r.SendStartTime) {
+		return ErrTljSendTime
+	}
+	switch r.UseEndTimeMode {
+	case TljUseEndTimeModeRelative:
+		if r.UseDays < 1 || r.UseDays > TljMaxUseDays {
+			return ErrTljUseTime
+		}
+	case TljUseEndTimeModeAbsolute:
+		if r.UseStartTime.IsZero() || r.UseEndTime.Before(r.UseStartTime) || r.UseEndTime.Format(TljDateFormat) < r.SendEndTime.Format(TljDateFormat) {
+			return ErrTljUseTime
+		}
+	default:
+		return ErrTljUseTime
+	}
+	return nil
+}
+
+// CheckGoods 使用单品详情的预估淘礼金(EstimateAmount)预检面额，EstimateAmount为0时不做限制
+func (r CreateTljRequest) CheckGoods(goods *GoodsDetail) error {
+	if goods.EstimateAmount > 1e-15 && r.PerFace > goods.EstimateAmount {
+		return ErrTljEstimateAmount
+	}
+	return nil
+}
+
+// Values implement Request interface
+func (r CreateTljRequest) Values(values url.Values) {
+	values.Set("alimamaAppKey", r.AlimamaAppKey)
+	values.Set("alimamaAppSecret", r.AlimamaAppSecret)
+	values.Set("name", r.Name)
+	values.Set("itemId", r.ItemID)
+	values.Set("perFace", strconv.FormatFloat(r.PerFace, 'f', 2, 64))
+	values.Set("totalNum", strconv.Itoa(r.TotalNum))
+	values.Set("winNumLimit", strconv.Itoa(r.WinNumLimit))
+	values.Set("sendStartTime", r.SendStartTime.Format(TljTimeFormat))
+	values.Set("sendEndTime", r.SendEndTime.Format(TljTimeFormat))
+	values.Set("useEndTimeMode", strconv.Itoa(r.UseEndTimeMode))
+	if r.UseEndTimeMode == TljUseEndTimeModeRelative {
+		values.Set("useEndTime", strconv.Itoa(r.UseDays))
+	} else {
+		values.Set("useStartTime", r.UseStartTime.Format(TljDateFormat))
+		values.Set("useEndTime", r.UseEndTime.Format(TljDateFormat))
+	}
+	if r.CampaignType != "" {
+		values.Set("campaignType", r.CampaignType)
+	}
+}
+
+// Url implement Request interface
+func (r CreateTljRequest) Url() string {
+	return "dels/taobao/kit/create-tlj"
+}
+
+// Tlj 淘礼金创建结果
+type Tlj struct {
+	// RightsID 淘礼金Id
+	RightsID string `json:"rightsId,omitempty"`
+	// SendURL 淘礼金领取Url
+	SendURL string `json:"sendUrl,omitempty"`
+	// VegasCode 投放code
+	VegasCode string `json:"vegasCode,omitempty"`
+	// AvailableFee 创建完成后资金账户可用资金，单位元，保留2位小数
+	AvailableFee string `json:"availableFee,omitempty"`
+}
+
+// CreateTlj 淘礼金创建，请求前会先调用Validate校验参数；请求包含阿里妈妈appSecret，使用POST避免出现在URL中
+func CreateTlj(clt *core.Client, req *CreateTljRequest, ret *Tlj) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	return clt.Post(req, ret)
+}
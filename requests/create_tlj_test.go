@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+func TestCreateTljPost(t *testing.T) {
+	const secret = "alimama-secret"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if strings.Contains(r.URL.RawQuery, secret) {
+			t.Errorf("alimamaAppSecret in query: %s", r.URL.RawQuery)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if got := r.PostForm.Get("alimamaAppSecret"); got != secret {
+			t.Errorf("form alimamaAppSecret = %q, want %q", got, secret)
+		}
+		w.Write([]byte(`{"code":0,"data":{"rightsId":"1"}}`))
+	}))
+	defer srv.Close()
+	clt := core.NewClient("key", "secret")
+	clt.SetGateway(srv.URL + "/")
+
+	now := time.Now()
+	req := CreateTljRequest{
+		AlimamaAppKey:    "alimama-key",
+		AlimamaAppSecret: secret,
+		Name:             "淘礼金",
+		ItemID:           "123",
+		PerFace:          1,
+		TotalNum:         10,
+		WinNumLimit:      1,
+		SendStartTime:    now,
+		SendEndTime:      now.Add(time.Hour),
+		UseEndTimeMode:   TljUseEndTimeModeRelative,
+		UseDays:          1,
+	}
+	var ret Tlj
+	if err := CreateTlj(clt, &req, &ret); err != nil {
+		t.Fatal(err)
+	}
+	if ret.RightsID != "1" {
+		t.Errorf("RightsID = %q, want 1", ret.RightsID)
+	}
+}
@@ -0,0 +1,55 @@
+package douyin
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ConvertRequest 抖音商品转链 API Request
+type ConvertRequest struct {
+	// ProductURL 商品链接或商品id
+	ProductURL string `json:"productUrl,omitempty"`
+	// Pid 推广位id
+	Pid string `json:"pid,omitempty"`
+	// ExternalInfo 自定义参数，仅支持数字、字母、下划线，长度不超过40
+	ExternalInfo string `json:"externalInfo,omitempty"`
+	// NeedQrCode 是否生成二维码
+	NeedQrCode bool `json:"needQrCode,omitempty"`
+}
+
+// Values implement Request interface
+func (r ConvertRequest) Values(values url.Values) {
+	values.Set("productUrl", r.ProductURL)
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+	if r.ExternalInfo != "" {
+		values.Set("externalInfo", r.ExternalInfo)
+	}
+	if r.NeedQrCode {
+		values.Set("needQrCode", "true")
+	}
+}
+
+// Url implement Request interface
+func (r ConvertRequest) Url() string {
+	return "dels/douyin/kit/convert"
+}
+
+// Link 抖音转链结果
+type Link struct {
+	// DyPassword 抖音口令
+	DyPassword string `json:"dyPassword,omitempty"`
+	// DyDeeplink 唤起抖音app的deeplink
+	DyDeeplink string `json:"dyDeeplink,omitempty"`
+	// DyZlink 抖音短链
+	DyZlink string `json:"dyZlink,omitempty"`
+	// QrCode 二维码
+	QrCode string `json:"qrCode,omitempty"`
+}
+
+// Convert 抖音商品转链
+func Convert(clt *core.Client, req *ConvertRequest, ret *Link) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,47 @@
+package douyin
+
+import "github.com/bububa/dataoke-go/util"
+
+// Goods 抖音商品信息
+type Goods struct {
+	// ProductID 商品id
+	ProductID string `json:"productId,omitempty"`
+	// Title 商品标题
+	Title string `json:"title,omitempty"`
+	// Cover 商品主图
+	Cover string `json:"cover,omitempty"`
+	// Imgs 商品轮播图
+	Imgs []string `json:"imgs,omitempty"`
+	// DetailURL 商品链接
+	DetailURL string `json:"detailUrl,omitempty"`
+	// Price 售价（元）
+	Price util.Float64 `json:"price,omitempty"`
+	// CouponPrice 券后价（元）
+	CouponPrice util.Float64 `json:"couponPrice,omitempty"`
+	// CosRatio 佣金比例（%）
+	CosRatio util.Float64 `json:"cosRatio,omitempty"`
+	// CosFee 预估佣金（元）
+	CosFee util.Float64 `json:"cosFee,omitempty"`
+	// Sales 销量
+	Sales int64 `json:"sales,omitempty"`
+	// ShopID 店铺id
+	ShopID uint64 `json:"shopId,omitempty"`
+	// ShopName 店铺名称
+	ShopName string `json:"shopName,omitempty"`
+	// FirstCid 一级类目id
+	FirstCid uint64 `json:"firstCid,omitempty"`
+	// SecondCid 二级类目id
+	SecondCid uint64 `json:"secondCid,omitempty"`
+	// ThirdCid 三级类目id
+	ThirdCid uint64 `json:"thirdCid,omitempty"`
+	// InStock 是否有货
+	InStock bool `json:"inStock,omitempty"`
+}
+
+// GoodsList 抖音商品列表分页结果
+type GoodsList struct {
+	// List 商品列表
+	List []Goods `json:"list,omitempty"`
+	// Total 商品总数
+	Total int64 `json:"total,omitempty"`
+}
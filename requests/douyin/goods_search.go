@@ -0,0 +1,73 @@
+package douyin
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GoodsSearchRequest 抖音商品搜索 API Request
+type GoodsSearchRequest struct {
+	// Title 商品关键词
+	Title string `json:"title,omitempty"`
+	// Page 页码，默认1
+	Page int `json:"page,omitempty"`
+	// Size 每页数量，默认20，最大20
+	Size int `json:"size,omitempty"`
+	// FirstCids 一级类目id，多个以英文逗号分隔
+	FirstCids string `json:"firstCids,omitempty"`
+	// PriceMin 最低价（分）
+	PriceMin int64 `json:"priceMin,omitempty"`
+	// PriceMax 最高价（分）
+	PriceMax int64 `json:"priceMax,omitempty"`
+	// CosRatioMin 最低佣金率，如：1234表示12.34%
+	CosRatioMin int `json:"cosRatioMin,omitempty"`
+	// SearchType 排序类型：0-综合，1-销量，2-价格，3-佣金，4-佣金比例
+	SearchType int `json:"searchType,omitempty"`
+	// SortType 排序方式：0-升序，1-降序
+	SortType int `json:"sortType,omitempty"`
+}
+
+// Values implement Request interface
+func (r GoodsSearchRequest) Values(values url.Values) {
+	if r.Title != "" {
+		values.Set("title", r.Title)
+	}
+	if r.Page < 1 {
+		r.Page = 1
+	}
+	values.Set("page", strconv.Itoa(r.Page))
+	if r.Size == 0 {
+		r.Size = 20
+	}
+	values.Set("size", strconv.Itoa(r.Size))
+	if r.FirstCids != "" {
+		values.Set("firstCids", r.FirstCids)
+	}
+	if r.PriceMin > 0 {
+		values.Set("priceMin", strconv.FormatInt(r.PriceMin, 10))
+	}
+	if r.PriceMax > 0 {
+		values.Set("priceMax", strconv.FormatInt(r.PriceMax, 10))
+	}
+	if r.CosRatioMin > 0 {
+		values.Set("cosRatioMin", strconv.Itoa(r.CosRatioMin))
+	}
+	if r.SearchType > 0 {
+		values.Set("searchType", strconv.Itoa(r.SearchType))
+	}
+	if r.SortType == 1 {
+		values.Set("sortType", "1")
+	}
+}
+
+// Url implement Request interface
+func (r GoodsSearchRequest) Url() string {
+	return "dels/douyin/goods/search"
+}
+
+// GoodsSearch 抖音商品搜索
+func GoodsSearch(clt *core.Client, req *GoodsSearchRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
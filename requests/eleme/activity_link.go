@@ -0,0 +1,68 @@
+package eleme
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ActivityLinkRequest 饿了么推广活动转链 API Request
+type ActivityLinkRequest struct {
+	// ActivityID 饿了么推广活动id
+	ActivityID string `json:"activityId,omitempty"`
+	// Pid 推广位id
+	Pid string `json:"pid,omitempty"`
+	// Sid 媒体自定义参数，长度不超过128
+	Sid string `json:"sid,omitempty"`
+	// IncludeQrCode 是否返回推广二维码
+	IncludeQrCode bool `json:"includeQrCode,omitempty"`
+	// IncludeWxImg 是否返回微信推广图片
+	IncludeWxImg bool `json:"includeWxImg,omitempty"`
+}
+
+// Values implement Request interface
+func (r ActivityLinkRequest) Values(values url.Values) {
+	values.Set("activityId", r.ActivityID)
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+	if r.Sid != "" {
+		values.Set("sid", r.Sid)
+	}
+	if r.IncludeQrCode {
+		values.Set("includeQrCode", "true")
+	}
+	if r.IncludeWxImg {
+		values.Set("includeWxImg", "true")
+	}
+}
+
+// Url implement Request interface
+func (r ActivityLinkRequest) Url() string {
+	return "dels/eleme/kit/activity-link"
+}
+
+// ActivityLink 饿了么推广活动链接
+type ActivityLink struct {
+	// H5URL h5推广链接
+	H5URL string `json:"h5Url,omitempty"`
+	// H5ShortLink h5推广短链接
+	H5ShortLink string `json:"h5ShortLink,omitempty"`
+	// Tpwd 淘口令
+	Tpwd string `json:"tpwd,omitempty"`
+	// WxAppID 微信小程序appId
+	WxAppID string `json:"wxAppId,omitempty"`
+	// WxPath 微信小程序路径
+	WxPath string `json:"wxPath,omitempty"`
+	// AlipayMiniURL 支付宝小程序推广链接
+	AlipayMiniURL string `json:"alipayMiniUrl,omitempty"`
+	// QrCodeURL 推广二维码
+	QrCodeURL string `json:"qrCodeUrl,omitempty"`
+	// WxImg 微信推广图片
+	WxImg string `json:"wxImg,omitempty"`
+}
+
+// GetActivityLink 饿了么推广活动转链
+func GetActivityLink(clt *core.Client, req *ActivityLinkRequest, ret *ActivityLink) error {
+	return clt.Get(req, ret)
+}
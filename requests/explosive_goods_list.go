@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ExplosiveGoodsListRequest 每日爆品推荐 API Request
+type ExplosiveGoodsListRequest struct {
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为20，最大值100
+	PageSize int `json:"pageSize,omitempty"`
+	// PriceCid 价格区间，1为5.9元区，2为9.9元区，3为19.9元区（不填默认为1）
+	PriceCid int `json:"priceCid,omitempty"`
+	// Cids 大淘客的一级分类id，如果需要传多个，以英文逗号相隔，如：”1,2,3”
+	Cids string `json:"cids,omitempty"`
+}
+
+// Values implement Request interface
+func (r ExplosiveGoodsListRequest) Values(values url.Values) {
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.PriceCid > 0 {
+		values.Set("priceCid", strconv.Itoa(r.PriceCid))
+	}
+	if r.Cids != "" {
+		values.Set("cids", r.Cids)
+	}
+}
+
+// Url implement Request interface
+func (r ExplosiveGoodsListRequest) Url() string {
+	return "goods/explosive-goods-list"
+}
+
+// ExplosiveGoodsList 每日爆品推荐
+func ExplosiveGoodsList(clt *core.Client, req *ExplosiveGoodsListRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
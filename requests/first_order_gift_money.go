@@ -0,0 +1,77 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// FirstOrderGiftMoneyRequest 首单礼金商品 API Request
+type FirstOrderGiftMoneyRequest struct {
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为20，最大值100
+	PageSize int `json:"pageSize,omitempty"`
+	// Cids 大淘客的一级分类id，如果需要传多个，以英文逗号相隔，如：”1,2,3”
+	Cids string `json:"cids,omitempty"`
+	// Keywords 关键词搜索
+	Keywords string `json:"keyWords,omitempty"`
+	// GoodsType 商品类型，1表示大淘客商品，2表示联盟商品，默认为1
+	GoodsType int `json:"goodsType,omitempty"`
+	// Sort 排序方式，默认为0，0-综合排序，1-商品上架时间从新到旧，2-销量从高到低，3-领券量从高到低，4-佣金比例从高到低，5-价格（券后价）从高到低，6-价格（券后价）从低到高
+	Sort string `json:"sort,omitempty"`
+}
+
+// Values implement Request interface
+func (r FirstOrderGiftMoneyRequest) Values(values url.Values) {
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.Cids != "" {
+		values.Set("cids", r.Cids)
+	}
+	if r.Keywords != "" {
+		values.Set("keyWords", r.Keywords)
+	}
+	if r.GoodsType > 0 {
+		values.Set("goodsType", strconv.Itoa(r.GoodsType))
+	}
+	if r.Sort != "" {
+		values.Set("sort", r.Sort)
+	}
+}
+
+// Url implement Request interface
+func (r FirstOrderGiftMoneyRequest) Url() string {
+	return "goods/first-order-gift-money"
+}
+
+// FirstOrderGiftMoneyGoods 首单礼金商品
+type FirstOrderGiftMoneyGoods struct {
+	GoodsDetail
+	// GiftMoney 首单礼金金额
+	GiftMoney float64 `json:"giftMoney,omitempty"`
+	// FinalPrice 使用首单礼金后的到手价
+	FinalPrice float64 `json:"finalPrice,omitempty"`
+}
+
+// FirstOrderGiftMoneyList 首单礼金商品分页结果
+type FirstOrderGiftMoneyList struct {
+	// List 商品列表
+	List []FirstOrderGiftMoneyGoods `json:"list,omitempty"`
+	// TotalNum 商品总数
+	TotalNum int64 `json:"totalNum,omitempty"`
+	// PageID 分页id，请求下一页时原样传入pageId
+	PageID string `json:"pageId,omitempty"`
+}
+
+// FirstOrderGiftMoney 首单礼金商品
+func FirstOrderGiftMoney(clt *core.Client, req *FirstOrderGiftMoneyRequest, ret *FirstOrderGiftMoneyList) error {
+	return clt.Get(req, ret)
+}
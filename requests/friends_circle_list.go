@@ -0,0 +1,138 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// FriendsCircleListRequest 朋友圈文案 API Request
+type FriendsCircleListRequest struct {
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为100，最大值200，若小于10，则按10条处理，每页条数仅支持输入10,50,100,200
+	PageSize int `json:"pageSize,omitempty"`
+	// Sort 排序字段，默认为0，0-综合排序，1-商品上架时间从新到旧，2-销量从高到低，3-领券量从高到低，4-佣金比例从高到低，5-价格（券后价）从高到低，6-价格（券后价）从低到高
+	Sort string `json:"sort,omitempty"`
+	// Cid 大淘客的一级分类id
+	Cid uint64 `json:"cid,omitempty"`
+	// SubCid 大淘客的二级类目id，通过超级分类API获取。仅允许传一个二级id，当一级类目id和二级类目id同时传入时，会自动忽略二级类目id
+	SubCid uint64 `json:"subcid,omitempty"`
+	// PreSale 是否只获取营销返现商品，1-是，0-否
+	PreSale int `json:"pre,omitempty"`
+	// Tmall 是否天猫商品，1-天猫商品，0-所有商品，不填默认为0
+	Tmall int `json:"tmall,omitempty"`
+	// TaoQiangGou 是否淘抢购商品，1-淘抢购商品，0-所有商品，不填默认为0
+	TaoQiangGou int `json:"taoqianggou,omitempty"`
+	// JuHuaSuan 是否聚划算商品，1-聚划算商品，0-所有商品，不填默认为0
+	JuHuaSuan int `json:"juhuasuan,omitempty"`
+	// GoldSeller 是否金牌卖家商品，1-金牌卖家商品，0-所有商品，不填默认为0
+	GoldSeller int `json:"goldSeller,omitempty"`
+	// Haitao 是否海淘商品，1-海淘商品，0-所有商品，不填默认为0
+	Haitao int `json:"haitao,omitempty"`
+	// Brand 是否品牌商品，1-品牌商品，0-所有商品，不填默认为0
+	Brand int `json:"brand,omitempty"`
+	// BrandIDs 品牌id，当brand传入0时，再传入brandIds将获取不到结果。品牌id可以传多个，以英文逗号隔开，如：345,321,323
+	BrandIDs string `json:"brandIds,omitempty"`
+}
+
+// Values implement Request interface
+func (r FriendsCircleListRequest) Values(values url.Values) {
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 100
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.Sort != "" {
+		values.Set("sort", r.Sort)
+	}
+	if r.Cid > 0 {
+		values.Set("cid", strconv.FormatUint(r.Cid, 10))
+	}
+	if r.SubCid > 0 {
+		values.Set("subcid", strconv.FormatUint(r.SubCid, 10))
+	}
+	if r.PreSale == 1 {
+		values.Set("pre", "1")
+	}
+	if r.Tmall == 1 {
+		values.Set("tmall", "1")
+	}
+	if r.TaoQiangGou == 1 {
+		values.Set("taoqianggou", "1")
+	}
+	if r.JuHuaSuan == 1 {
+		values.Set("juhuasuan", "1")
+	}
+	if r.GoldSeller == 1 {
+		values.Set("goldSeller", "1")
+	}
+	if r.Haitao == 1 {
+		values.Set("haitao", "1")
+	}
+	if r.Brand == 1 {
+		values.Set("brand", "1")
+	}
+	if r.BrandIDs != "" {
+		values.Set("brandIds", r.BrandIDs)
+	}
+}
+
+// Url implement Request interface
+func (r FriendsCircleListRequest) Url() string {
+	return "goods/friends-circle-list"
+}
+
+// FriendsCircleGoods 朋友圈文案商品
+type FriendsCircleGoods struct {
+	GoodsDetail
+	// CircleText 朋友圈文案
+	CircleText string `json:"circleText,omitempty"`
+	// Comments 评论区文案
+	Comments []FriendsCircleComment `json:"comments,omitempty"`
+}
+
+// FriendsCircleComment 评论区文案
+type FriendsCircleComment struct {
+	// Text 评论文案
+	Text string `json:"text,omitempty"`
+	// Images 评论配图
+	Images []string `json:"images,omitempty"`
+}
+
+// FriendsCircleList 朋友圈文案分页结果
+type FriendsCircleList struct {
+	// List 商品列表
+	List []FriendsCircleGoods `json:"list,omitempty"`
+	// TotalNum 商品总数
+	TotalNum int64 `json:"totalNum,omitempty"`
+	// PageID 分页id，请求下一页时原样传入pageId
+	PageID string `json:"pageId,omitempty"`
+}
+
+// Post 组合朋友圈文案与转链结果生成可直接发布的文案，Tpwd为空时使用ShortURL
+func (g FriendsCircleGoods) Post(link *PrivilegeLink) string {
+	if link == nil {
+		return g.CircleText
+	}
+	share := link.Tpwd
+	if share == "" {
+		share = link.ShortURL
+	}
+	if share == "" {
+		return g.CircleText
+	}
+	if g.CircleText == "" {
+		return share
+	}
+	return g.CircleText + "\n" + share
+}
+
+// GetFriendsCircleList 朋友圈文案
+func GetFriendsCircleList(clt *core.Client, req *FriendsCircleListRequest, ret *FriendsCircleList) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,41 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GetBrandGoodsListRequest 品牌商品 API Request
+type GetBrandGoodsListRequest struct {
+	// BrandID 品牌id
+	BrandID uint64 `json:"brandId,omitempty"`
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为20，最大值100
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetBrandGoodsListRequest) Values(values url.Values) {
+	values.Set("brandId", strconv.FormatUint(r.BrandID, 10))
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+}
+
+// Url implement Request interface
+func (r GetBrandGoodsListRequest) Url() string {
+	return "delanys/brand/get-goods-list"
+}
+
+// GetBrandGoodsList 品牌商品
+func GetBrandGoodsList(clt *core.Client, req *GetBrandGoodsListRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
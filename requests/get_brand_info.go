@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GetBrandInfoRequest 单个品牌详情 API Request
+type GetBrandInfoRequest struct {
+	// BrandID 品牌id
+	BrandID uint64 `json:"brandId,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetBrandInfoRequest) Values(values url.Values) {
+	values.Set("brandId", strconv.FormatUint(r.BrandID, 10))
+}
+
+// Url implement Request interface
+func (r GetBrandInfoRequest) Url() string {
+	return "delanys/brand/get-brand-info"
+}
+
+// GetBrandInfo 单个品牌详情
+func GetBrandInfo(clt *core.Client, req *GetBrandInfoRequest, ret *Brand) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,53 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GetCollectionListRequest 我的收藏 API Request，返回当前大淘客账号在后台收藏的商品
+type GetCollectionListRequest struct {
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为100，最大值200
+	PageSize int `json:"pageSize,omitempty"`
+	// Cid 大淘客的一级分类id，如果需要传多个，以英文逗号相隔，如：”1,2,3”
+	Cid string `json:"cid,omitempty"`
+	// TrailerType 是否只获取预告商品，1-预告商品，0-所有商品，不填默认为0
+	TrailerType int `json:"trailerType,omitempty"`
+	// Sort 排序字段信息，默认为0，0-综合排序，1-商品上架时间从新到旧，2-销量从高到低，3-领券量从高到低，4-佣金比例从高到低，5-价格（券后价）从高到低，6-价格（券后价）从低到高
+	Sort string `json:"sort,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetCollectionListRequest) Values(values url.Values) {
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 100
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.Cid != "" {
+		values.Set("cid", r.Cid)
+	}
+	if r.TrailerType == 1 {
+		values.Set("trailerType", "1")
+	}
+	if r.Sort != "" {
+		values.Set("sort", r.Sort)
+	}
+}
+
+// Url implement Request interface
+func (r GetCollectionListRequest) Url() string {
+	return "goods/get-collection-list"
+}
+
+// GetCollectionList 我的收藏
+func GetCollectionList(clt *core.Client, req *GetCollectionListRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
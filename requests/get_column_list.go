@@ -0,0 +1,53 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GetColumnListRequest 品牌栏目 API Request
+type GetColumnListRequest struct {
+	// Cid 大淘客一级分类id
+	Cid uint64 `json:"cid,omitempty"`
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为20，最大值100
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetColumnListRequest) Values(values url.Values) {
+	if r.Cid > 0 {
+		values.Set("cid", strconv.FormatUint(r.Cid, 10))
+	}
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+}
+
+// Url implement Request interface
+func (r GetColumnListRequest) Url() string {
+	return "delanys/brand/get-column-list"
+}
+
+// BrandList 品牌列表分页结果
+type BrandList struct {
+	// Lists 品牌列表
+	Lists []Brand `json:"lists,omitempty"`
+	// TotalNum 品牌总数
+	TotalNum int64 `json:"totalNum,omitempty"`
+	// PageID 分页id，请求下一页时原样传入pageId
+	PageID string `json:"pageId,omitempty"`
+}
+
+// GetColumnList 品牌栏目
+func GetColumnList(clt *core.Client, req *GetColumnListRequest, ret *BrandList) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GetHistoryLowPriceListRequest 历史新低商品合集 API Request
+type GetHistoryLowPriceListRequest struct {
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为20，最大值200
+	PageSize int `json:"pageSize,omitempty"`
+	// Cids 大淘客的一级分类id，如果需要传多个，以英文逗号相隔，如：”1,2,3”
+	Cids string `json:"cids,omitempty"`
+	// Sort 排序方式，默认为0，0-综合排序，1-商品上架时间从新到旧，2-销量从高到低，3-领券量从高到低，4-佣金比例从高到低，5-价格（券后价）从高到低，6-价格（券后价）从低到高
+	Sort string `json:"sort,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetHistoryLowPriceListRequest) Values(values url.Values) {
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.Cids != "" {
+		values.Set("cids", r.Cids)
+	}
+	if r.Sort != "" {
+		values.Set("sort", r.Sort)
+	}
+}
+
+// Url implement Request interface
+func (r GetHistoryLowPriceListRequest) Url() string {
+	return "goods/get-history-low-price-list"
+}
+
+// GetHistoryLowPriceList 历史新低商品合集
+func GetHistoryLowPriceList(clt *core.Client, req *GetHistoryLowPriceListRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,274 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bububa/dataoke-go/core"
+	"github.com/bububa/dataoke-go/util"
+)
+
+// OrderTimeFormat 订单查询时间格式
+const OrderTimeFormat = "2006-01-02 15:04:05"
+
+// OrderMaxWindow 订单查询单次时间窗口上限
+const OrderMaxWindow = 3 * time.Hour
+
+// OrderMaxPageNo 订单查询页码上限
+const OrderMaxPageNo = 100
+
+// OrderQueryType 订单查询时间类型
+type OrderQueryType int
+
+const (
+	// OrderQueryByCreateTime 按照订单淘客创建时间查询
+	OrderQueryByCreateTime OrderQueryType = 1
+	// OrderQueryByPaidTime 按照订单淘客付款时间查询
+	OrderQueryByPaidTime OrderQueryType = 2
+	// OrderQueryByEarningTime 按照订单淘客结算时间查询
+	OrderQueryByEarningTime OrderQueryType = 3
+	// OrderQueryByModifiedTime 按照订单更新时间查询
+	OrderQueryByModifiedTime OrderQueryType = 4
+)
+
+// OrderMemberType 推广者角色类型
+type OrderMemberType int
+
+const (
+	// OrderMemberAll 全部
+	OrderMemberAll OrderMemberType = 0
+	// OrderMemberSecondParty 二方
+	OrderMemberSecondParty OrderMemberType = 2
+	// OrderMemberThirdParty 三方
+	OrderMemberThirdParty OrderMemberType = 3
+)
+
+// OrderTkStatus 淘客订单状态
+type OrderTkStatus int
+
+const (
+	// OrderTkStatusAll 全部
+	OrderTkStatusAll OrderTkStatus = 0
+	// OrderTkStatusSettled 订单结算
+	OrderTkStatusSettled OrderTkStatus = 3
+	// OrderTkStatusPaid 订单付款
+	OrderTkStatusPaid OrderTkStatus = 12
+	// OrderTkStatusInvalid 订单失效
+	OrderTkStatusInvalid OrderTkStatus = 13
+	// OrderTkStatusSuccess 订单成功
+	OrderTkStatusSuccess OrderTkStatus = 14
+)
+
+// String implement Stringer interface
+func (s OrderTkStatus) String() string {
+	switch s {
+	case OrderTkStatusSettled:
+		return "订单结算"
+	case OrderTkStatusPaid:
+		return "订单付款"
+	case OrderTkStatusInvalid:
+		return "订单失效"
+	case OrderTkStatusSuccess:
+		return "订单成功"
+	}
+	return "全部"
+}
+
+// OrderScene 订单场景类型
+type OrderScene int
+
+const (
+	// OrderSceneNormal 常规订单
+	OrderSceneNormal OrderScene = 1
+	// OrderSceneChannel 渠道订单
+	OrderSceneChannel OrderScene = 2
+	// OrderSceneMember 会员运营订单
+	OrderSceneMember OrderScene = 3
+)
+
+// GetOrderDetailsRequest 订单查询 API Request
+type GetOrderDetailsRequest struct {
+	// QueryType 查询时间类型，1：按照订单淘客创建时间查询，2:按照订单淘客付款时间查询，3:按照订单淘客结算时间查询，4:按照订单更新时间
+	QueryType OrderQueryType `json:"queryType,omitempty"`
+	// PositionIndex 位点，除第一页之外，都需要传递；前端原样返回
+	PositionIndex string `json:"positionIndex,omitempty"`
+	// PageSize 页大小，默认20，1~100
+	PageSize int `json:"pageSize,omitempty"`
+	// MemberType 推广者角色类型,2:二方，3:三方，不传，表示所有角色
+	MemberType OrderMemberType `json:"memberType,omitempty"`
+	// TkStatus 淘客订单状态，12-付款，13-关闭，14-确认收货，3-结算成功;不传，表示所有状态
+	TkStatus OrderTkStatus `json:"tkStatus,omitempty"`
+	// StartTime 订单查询开始时间
+	StartTime time.Time `json:"startTime,omitempty"`
+	// EndTime 订单查询结束时间，与开始时间间隔不超过3小时
+	EndTime time.Time `json:"endTime,omitempty"`
+	// JumpType 跳转类型，当向前或者向后翻页必须提供,-1: 前一页，1: 后一页
+	JumpType int `json:"jumpType,omitempty"`
+	// PageNo 第几页，默认1，1~100
+	PageNo int `json:"pageNo,omitempty"`
+	// OrderScene 场景订单场景类型，1:常规订单，2:渠道订单，3:会员运营订单，默认为1
+	OrderScene OrderScene `json:"orderScene,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetOrderDetailsRequest) Values(values url.Values) {
+	if r.QueryType == 0 {
+		r.QueryType = OrderQueryByCreateTime
+	}
+	values.Set("queryType", strconv.Itoa(int(r.QueryType)))
+	if r.PositionIndex != "" {
+		values.Set("positionIndex", r.PositionIndex)
+	}
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.MemberType > 0 {
+		values.Set("memberType", strconv.Itoa(int(r.MemberType)))
+	}
+	if r.TkStatus > 0 {
+		values.Set("tkStatus", strconv.Itoa(int(r.TkStatus)))
+	}
+	values.Set("startTime", r.StartTime.Format(OrderTimeFormat))
+	values.Set("endTime", r.EndTime.Format(OrderTimeFormat))
+	if r.JumpType != 0 {
+		values.Set("jumpType", strconv.Itoa(r.JumpType))
+	}
+	if r.PageNo < 1 {
+		r.PageNo = 1
+	}
+	values.Set("pageNo", strconv.Itoa(r.PageNo))
+	if r.OrderScene > 0 {
+		values.Set("orderScene", strconv.Itoa(int(r.OrderScene)))
+	}
+}
+
+// Url implement Request interface
+func (r GetOrderDetailsRequest) Url() string {
+	return "tb-service/get-order-details"
+}
+
+// OrderDetails 订单查询结果
+type OrderDetails struct {
+	// HasNext 是否有下一页
+	HasNext bool `json:"has_next,omitempty"`
+	// HasPre 是否有上一页
+	HasPre bool `json:"has_pre,omitempty"`
+	// PageNo 页码
+	PageNo int `json:"page_no,omitempty"`
+	// PageSize 页大小
+	PageSize int `json:"page_size,omitempty"`
+	// PositionIndex 位点字段，由调用方原样传递
+	PositionIndex string `json:"position_index,omitempty"`
+	// Results 订单列表
+	Results struct {
+		PublisherOrderDto []Order `json:"publisher_order_dto,omitempty"`
+	} `json:"results,omitempty"`
+}
+
+// Orders 订单列表
+func (d OrderDetails) Orders() []Order {
+	return d.Results.PublisherOrderDto
+}
+
+// Order 淘客订单
+type Order struct {
+	// TradeParentID 买家在淘宝后台显示的订单编号
+	TradeParentID string `json:"trade_parent_id,omitempty"`
+	// TradeID 买家通过购物车购买的每个商品对应的订单编号
+	TradeID string `json:"trade_id,omitempty"`
+	// ItemID 商品id
+	ItemID string `json:"item_id,omitempty"`
+	// ItemTitle 商品标题
+	ItemTitle string `json:"item_title,omitempty"`
+	// ItemImg 商品图片
+	ItemImg string `json:"item_img,omitempty"`
+	// ItemLink 商品链接
+	ItemLink string `json:"item_link,omitempty"`
+	// ItemPrice 商品单价
+	ItemPrice util.Float64 `json:"item_price,omitempty"`
+	// ItemNum 商品数量
+	ItemNum util.Int64 `json:"item_num,omitempty"`
+	// ItemCategoryName 商品所属的根类目
+	ItemCategoryName string `json:"item_category_name,omitempty"`
+	// SellerNick 掌柜旺旺
+	SellerNick string `json:"seller_nick,omitempty"`
+	// SellerShopTitle 店铺名称
+	SellerShopTitle string `json:"seller_shop_title,omitempty"`
+	// TkStatus 淘客订单状态，3：订单结算，12：订单付款， 13：订单失效，14：订单成功
+	TkStatus OrderTkStatus `json:"tk_status,omitempty"`
+	// OrderType 订单所属平台类型，包括天猫、淘宝、聚划算等
+	OrderType string `json:"order_type,omitempty"`
+	// FlowSource 产品类型
+	FlowSource string `json:"flow_source,omitempty"`
+	// TerminalType 成交平台，PC或无线
+	TerminalType string `json:"terminal_type,omitempty"`
+	// ClickTime 通过推广链接达到商品、店铺详情页的点击时间
+	ClickTime string `json:"click_time,omitempty"`
+	// TkCreateTime 订单创建的时间
+	TkCreateTime string `json:"tk_create_time,omitempty"`
+	// TbPaidTime 订单在淘宝拍下付款的时间
+	TbPaidTime string `json:"tb_paid_time,omitempty"`
+	// TkPaidTime 订单付款的时间
+	TkPaidTime string `json:"tk_paid_time,omitempty"`
+	// TkEarningTime 订单确认收货后且商家完成佣金支付的时间
+	TkEarningTime string `json:"tk_earning_time,omitempty"`
+	// ModifiedTime 订单更新时间
+	ModifiedTime string `json:"modified_time,omitempty"`
+	// AlipayTotalPrice 买家拍下付款的金额
+	AlipayTotalPrice util.Float64 `json:"alipay_total_price,omitempty"`
+	// PayPrice 买家确认收货的付款金额
+	PayPrice util.Float64 `json:"pay_price,omitempty"`
+	// IncomeRate 订单结算的佣金比率+平台的补贴比率
+	IncomeRate util.Float64 `json:"income_rate,omitempty"`
+	// TotalCommissionRate 佣金比率
+	TotalCommissionRate util.Float64 `json:"total_commission_rate,omitempty"`
+	// TotalCommissionFee 佣金金额
+	TotalCommissionFee util.Float64 `json:"total_commission_fee,omitempty"`
+	// PubShareRate 从结算佣金中分得的收益比率
+	PubShareRate util.Float64 `json:"pub_share_rate,omitempty"`
+	// PubSharePreFee 付款预估收入
+	PubSharePreFee util.Float64 `json:"pub_share_pre_fee,omitempty"`
+	// PubShareFee 结算预估收入
+	PubShareFee util.Float64 `json:"pub_share_fee,omitempty"`
+	// TkTotalRate 提成比率
+	TkTotalRate util.Float64 `json:"tk_total_rate,omitempty"`
+	// AlimamaRate 推广者赚取佣金后支付给阿里妈妈的技术服务费用的比率
+	AlimamaRate util.Float64 `json:"alimama_rate,omitempty"`
+	// AlimamaShareFee 技术服务费
+	AlimamaShareFee util.Float64 `json:"alimama_share_fee,omitempty"`
+	// SubsidyRate 平台给与的补贴比率
+	SubsidyRate util.Float64 `json:"subsidy_rate,omitempty"`
+	// SubsidyFee 补贴金额
+	SubsidyFee util.Float64 `json:"subsidy_fee,omitempty"`
+	// SubsidyType 平台出资方
+	SubsidyType string `json:"subsidy_type,omitempty"`
+	// DepositPrice 预售时期，用户对预售商品支付的定金金额
+	DepositPrice util.Float64 `json:"deposit_price,omitempty"`
+	// TbDepositTime 预售时期，用户对预售商品支付定金的付款时间
+	TbDepositTime string `json:"tb_deposit_time,omitempty"`
+	// TkDepositTime 预售时期，用户对预售商品支付定金的付款时间，可能略晚于在淘宝付定金时间
+	TkDepositTime string `json:"tk_deposit_time,omitempty"`
+	// PubID 推广者的会员id
+	PubID util.Uint64 `json:"pub_id,omitempty"`
+	// SiteID 媒体管理下的ID
+	SiteID util.Uint64 `json:"site_id,omitempty"`
+	// SiteName 媒体名称
+	SiteName string `json:"site_name,omitempty"`
+	// AdzoneID 推广位管理下的推广位ID
+	AdzoneID util.Uint64 `json:"adzone_id,omitempty"`
+	// AdzoneName 推广位名称
+	AdzoneName string `json:"adzone_name,omitempty"`
+	// RelationID 渠道关系id
+	RelationID util.Uint64 `json:"relation_id,omitempty"`
+	// SpecialID 会员运营id
+	SpecialID util.Uint64 `json:"special_id,omitempty"`
+	// RefundTag 维权标签，0 含义为非维权 1 含义为维权订单
+	RefundTag int `json:"refund_tag,omitempty"`
+}
+
+// GetOrderDetails 订单查询
+func GetOrderDetails(clt *core.Client, req *GetOrderDetailsRequest, ret *OrderDetails) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,68 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+	"github.com/bububa/dataoke-go/util"
+)
+
+// GetShopConvertRequest 店铺转链 API Request
+type GetShopConvertRequest struct {
+	// SellerID 店铺ID，即单品详情中的sellerId
+	SellerID util.Uint64 `json:"sellerId,omitempty"`
+	// Pid 推广位ID，用户可自由填写当前大淘客账号下已授权淘宝账号的任一pid，若未填写，则默认使用创建应用时绑定的pid
+	Pid string `json:"pid,omitempty"`
+	// ExternalID 淘宝客外部用户标记，如自身系统账户ID；微信ID等
+	ExternalID string `json:"externalId,omitempty"`
+	// RelationID 渠道关系ID
+	RelationID string `json:"relationId,omitempty"`
+	// ShopName 店铺名称
+	ShopName string `json:"shopName,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetShopConvertRequest) Values(values url.Values) {
+	values.Set("sellerId", strconv.FormatUint(r.SellerID.Uint64(), 10))
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+	if r.ExternalID != "" {
+		values.Set("externalId", r.ExternalID)
+	}
+	if r.RelationID != "" {
+		values.Set("relationId", r.RelationID)
+	}
+	if r.ShopName != "" {
+		values.Set("shopName", r.ShopName)
+	}
+}
+
+// Url implement Request interface
+func (r GetShopConvertRequest) Url() string {
+	return "tb-service/get-shop-convert"
+}
+
+// ShopConvert 店铺转链结果
+type ShopConvert struct {
+	// SellerID 店铺ID
+	SellerID util.Uint64 `json:"sellerId,omitempty"`
+	// ShopName 店铺名称
+	ShopName string `json:"shopName,omitempty"`
+	// ShopLogo 店铺logo
+	ShopLogo string `json:"shopLogo,omitempty"`
+	// ShopLinks 店铺推广链接
+	ShopLinks string `json:"shopLinks,omitempty"`
+	// Tpwd 淘口令
+	Tpwd string `json:"tpwd,omitempty"`
+	// LongTpwd 长淘口令
+	LongTpwd string `json:"longTpwd,omitempty"`
+	// ShortURL 短链接
+	ShortURL string `json:"shortUrl,omitempty"`
+}
+
+// GetShopConvert 店铺转链
+func GetShopConvert(clt *core.Client, req *GetShopConvertRequest, ret *ShopConvert) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GetSuperCategoryRequest 超级分类 API Request
+type GetSuperCategoryRequest struct{}
+
+// Values implement Request interface
+func (r GetSuperCategoryRequest) Values(values url.Values) {}
+
+// Url implement Request interface
+func (r GetSuperCategoryRequest) Url() string {
+	return "category/get-super-category"
+}
+
+// SuperCategory 超级分类一级分类
+type SuperCategory struct {
+	// Cid 一级分类ID
+	Cid uint64 `json:"cid,omitempty"`
+	// Cname 一级分类名称
+	Cname string `json:"cname,omitempty"`
+	// Cpic 一级分类图标
+	Cpic string `json:"cpic,omitempty"`
+	// Subcategories 二级分类
+	Subcategories []SubCategory `json:"subcategories,omitempty"`
+}
+
+// SubCategory 超级分类二级分类
+type SubCategory struct {
+	// SubCid 二级分类ID
+	SubCid uint64 `json:"subcid,omitempty"`
+	// ScName 二级分类名称
+	ScName string `json:"scname,omitempty"`
+	// ScPic 二级分类图标
+	ScPic string `json:"scpic,omitempty"`
+}
+
+// GetSuperCategory 超级分类
+func GetSuperCategory(clt *core.Client, ret *[]SuperCategory) error {
+	return clt.Get(GetSuperCategoryRequest{}, ret)
+}
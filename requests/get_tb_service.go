@@ -167,7 +167,7 @@ type TbkItem struct {
 	NumIid string `json:"num_iid,omitempty"`
 	// ItemID 商品信息-宝贝id
 	ItemID string `json:"item_id,omitempty"`
-	// CommissionRate 佣金比例
+	// CommissionRate 佣金比例，万分比，如：1550表示15.50%
 	CommissionRate float64 `json:"commission_rate,omitempty"`
 	// YsylJltFace 预估淘礼金
 	YsylJltFace util.Float64 `json:"ysyl_jlt_face,omitempty"`
@@ -185,6 +185,11 @@ type TbkItem struct {
 	CpaRewardAmount util.Float64 `json:"cpa_reward_amount,omitempty"`
 }
 
+// CommissionRatePercent 佣金比例，百分比，如：15.5表示15.50%
+func (t TbkItem) CommissionRatePercent() float64 {
+	return t.CommissionRate / 100
+}
+
 // GetTbService 联盟搜索
 func GetTbService(clt *core.Client, req *GetTbServiceRequest, ret *[]TbkItem) error {
 	return clt.Get(req, ret)
@@ -0,0 +1,64 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GetTbTopicListRequest 官方活动会场 API Request
+type GetTbTopicListRequest struct {
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为20
+	PageSize int `json:"pageSize,omitempty"`
+	// Type 输出的端口类型：0.全部（默认），1.PC，2.无线
+	Type int `json:"type,omitempty"`
+	// Channel 阿里妈妈上申请的渠道id
+	Channel string `json:"channel,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetTbTopicListRequest) Values(values url.Values) {
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.Type > 0 {
+		values.Set("type", strconv.Itoa(r.Type))
+	}
+	if r.Channel != "" {
+		values.Set("channel", r.Channel)
+	}
+}
+
+// Url implement Request interface
+func (r GetTbTopicListRequest) Url() string {
+	return "category/get-tb-topic-list"
+}
+
+// TbTopic 官方活动会场
+type TbTopic struct {
+	// ID 会场id，即活动转链的promotionSceneId
+	ID string `json:"id,omitempty"`
+	// ActivityName 会场名称
+	ActivityName string `json:"activityName,omitempty"`
+	// StartTime 会场开始时间
+	StartTime string `json:"startTime,omitempty"`
+	// EndTime 会场结束时间
+	EndTime string `json:"endTime,omitempty"`
+	// Banner 会场banner图
+	Banner string `json:"banner,omitempty"`
+	// ActivityURL 会场链接
+	ActivityURL string `json:"activityUrl,omitempty"`
+}
+
+// GetTbTopicList 官方活动会场
+func GetTbTopicList(clt *core.Client, req *GetTbTopicListRequest, ret *[]TbTopic) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,11 @@
+package requests
+
+// GoodsList 商品列表分页结果
+type GoodsList struct {
+	// List 商品列表
+	List []GoodsDetail `json:"list,omitempty"`
+	// TotalNum 商品总数
+	TotalNum int64 `json:"totalNum,omitempty"`
+	// PageID 分页id，请求下一页时原样传入pageId
+	PageID string `json:"pageId,omitempty"`
+}
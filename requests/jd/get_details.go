@@ -0,0 +1,28 @@
+package jd
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GetDetailsRequest 京东商品详情 API Request
+type GetDetailsRequest struct {
+	// SkuIDs 商品skuId，多个使用英文逗号分隔，最多支持10个
+	SkuIDs string `json:"skuIds,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetDetailsRequest) Values(values url.Values) {
+	values.Set("skuIds", r.SkuIDs)
+}
+
+// Url implement Request interface
+func (r GetDetailsRequest) Url() string {
+	return "dels/jd/goods/get-details"
+}
+
+// GetDetails 京东商品详情
+func GetDetails(clt *core.Client, req *GetDetailsRequest, ret *[]Goods) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,121 @@
+package jd
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/bububa/dataoke-go/core"
+	"github.com/bububa/dataoke-go/util"
+)
+
+// OrderTimeFormat 京东订单查询时间格式
+const OrderTimeFormat = "2006-01-02 15:04:05"
+
+// GetOfficialOrderListRequest 京东订单查询 API Request
+type GetOfficialOrderListRequest struct {
+	// Key 京东联盟授权key
+	Key string `json:"key,omitempty"`
+	// StartTime 开始时间，与结束时间间隔不超过1小时
+	StartTime time.Time `json:"startTime,omitempty"`
+	// EndTime 结束时间
+	EndTime time.Time `json:"endTime,omitempty"`
+	// Type 订单时间查询类型(1：下单时间，2：完成时间（购买用户确认收货时间），3：更新时间
+	Type int `json:"type,omitempty"`
+	// PageNo 页码，默认1
+	PageNo int `json:"pageNo,omitempty"`
+	// PageSize 每页包含条数，上限为500，默认20
+	PageSize int `json:"pageSize,omitempty"`
+	// ChildUnionID 子推客unionID，传入该值可查询子推客的订单
+	ChildUnionID string `json:"childUnionId,omitempty"`
+}
+
+// Values implement Request interface
+func (r GetOfficialOrderListRequest) Values(values url.Values) {
+	values.Set("key", r.Key)
+	values.Set("startTime", r.StartTime.Format(OrderTimeFormat))
+	values.Set("endTime", r.EndTime.Format(OrderTimeFormat))
+	if r.Type == 0 {
+		r.Type = 1
+	}
+	values.Set("type", strconv.Itoa(r.Type))
+	if r.PageNo < 1 {
+		r.PageNo = 1
+	}
+	values.Set("pageNo", strconv.Itoa(r.PageNo))
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.ChildUnionID != "" {
+		values.Set("childUnionId", r.ChildUnionID)
+	}
+}
+
+// Url implement Request interface
+func (r GetOfficialOrderListRequest) Url() string {
+	return "dels/jd/order/get-official-order-list"
+}
+
+// Order 京东推广订单
+type Order struct {
+	// ID 标记唯一订单行
+	ID string `json:"id,omitempty"`
+	// OrderID 订单号
+	OrderID util.Uint64 `json:"orderId,omitempty"`
+	// ParentID 主单的订单号，如一个订单拆成多个子订单时，原订单号会作为主单号，拆分的订单号为子单号存储在orderid中。若未发生拆单，该字段为0
+	ParentID util.Uint64 `json:"parentId,omitempty"`
+	// OrderTime 下单时间
+	OrderTime string `json:"orderTime,omitempty"`
+	// FinishTime 完成时间（购买用户确认收货时间）
+	FinishTime string `json:"finishTime,omitempty"`
+	// ModifyTime 更新时间
+	ModifyTime string `json:"modifyTime,omitempty"`
+	// SkuID 商品ID
+	SkuID util.Uint64 `json:"skuId,omitempty"`
+	// SkuName 商品名称
+	SkuName string `json:"skuName,omitempty"`
+	// SkuNum 商品数量
+	SkuNum util.Int64 `json:"skuNum,omitempty"`
+	// SkuReturnNum 商品已退货数量
+	SkuReturnNum util.Int64 `json:"skuReturnNum,omitempty"`
+	// Price 商品单价
+	Price util.Float64 `json:"price,omitempty"`
+	// CommissionRate 佣金比例(投放的广告主计划比例)
+	CommissionRate util.Float64 `json:"commissionRate,omitempty"`
+	// FinalRate 最终比例（分成比例+补贴比例）
+	FinalRate util.Float64 `json:"finalRate,omitempty"`
+	// EstimateCosPrice 预估计佣金额
+	EstimateCosPrice util.Float64 `json:"estimateCosPrice,omitempty"`
+	// EstimateFee 推客的预估佣金
+	EstimateFee util.Float64 `json:"estimateFee,omitempty"`
+	// ActualCosPrice 实际计算佣金的金额
+	ActualCosPrice util.Float64 `json:"actualCosPrice,omitempty"`
+	// ActualFee 推客获得的实际佣金
+	ActualFee util.Float64 `json:"actualFee,omitempty"`
+	// ValidCode sku维度的有效码（-1：未知,2.无效-拆单,3.无效-取消,4.无效-京东帮帮主订单,5.无效-账号异常,6.无效-赠品类目不返佣 等,15.待付款,16.已付款,17.已完成,18.已结算）
+	ValidCode int `json:"validCode,omitempty"`
+	// UnionID 推客的联盟ID
+	UnionID util.Uint64 `json:"unionId,omitempty"`
+	// PositionID 推广位ID
+	PositionID util.Uint64 `json:"positionId,omitempty"`
+	// SubUnionID 子渠道标识
+	SubUnionID string `json:"subUnionId,omitempty"`
+	// Pid 格式:子推客ID_子站长应用ID_子推客推广位ID
+	Pid string `json:"pid,omitempty"`
+	// PayMonth 预估结算时间，格式：yyyyMMdd，0：未结算
+	PayMonth string `json:"payMonth,omitempty"`
+}
+
+// OrderList 京东订单查询结果
+type OrderList struct {
+	// HasMore 是否还有更多
+	HasMore bool `json:"hasMore,omitempty"`
+	// List 订单列表
+	List []Order `json:"list,omitempty"`
+}
+
+// GetOfficialOrderList 京东订单查询
+func GetOfficialOrderList(clt *core.Client, req *GetOfficialOrderListRequest, ret *OrderList) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,153 @@
+package jd
+
+import "github.com/bububa/dataoke-go/util"
+
+// Goods 京东商品信息
+type Goods struct {
+	// SkuID 商品ID
+	SkuID util.Uint64 `json:"skuId,omitempty"`
+	// SkuName 商品名称
+	SkuName string `json:"skuName,omitempty"`
+	// Spuid 其值为同款商品的主skuid
+	Spuid util.Uint64 `json:"spuid,omitempty"`
+	// BrandCode 品牌code
+	BrandCode string `json:"brandCode,omitempty"`
+	// BrandName 品牌名
+	BrandName string `json:"brandName,omitempty"`
+	// Owner g=自营，p=pop
+	Owner string `json:"owner,omitempty"`
+	// MaterialURL 商品落地页
+	MaterialURL string `json:"materialUrl,omitempty"`
+	// Comments 评论数
+	Comments int64 `json:"comments,omitempty"`
+	// GoodCommentsShare 商品好评率
+	GoodCommentsShare float64 `json:"goodCommentsShare,omitempty"`
+	// InOrderCount30Days 30天引单数量
+	InOrderCount30Days int64 `json:"inOrderCount30Days,omitempty"`
+	// IsHot 是否爆款，1：是，0：否
+	IsHot int `json:"isHot,omitempty"`
+	// CategoryInfo 类目信息
+	CategoryInfo *CategoryInfo `json:"categoryInfo,omitempty"`
+	// CommissionInfo 佣金信息
+	CommissionInfo *CommissionInfo `json:"commissionInfo,omitempty"`
+	// CouponInfo 优惠券信息
+	CouponInfo *CouponInfo `json:"couponInfo,omitempty"`
+	// ImageInfo 图片信息
+	ImageInfo *ImageInfo `json:"imageInfo,omitempty"`
+	// PriceInfo 价格信息
+	PriceInfo *PriceInfo `json:"priceInfo,omitempty"`
+	// ShopInfo 店铺信息
+	ShopInfo *ShopInfo `json:"shopInfo,omitempty"`
+}
+
+// CategoryInfo 京东商品类目信息
+type CategoryInfo struct {
+	// Cid1 一级类目ID
+	Cid1 util.Uint64 `json:"cid1,omitempty"`
+	// Cid1Name 一级类目名称
+	Cid1Name string `json:"cid1Name,omitempty"`
+	// Cid2 二级类目ID
+	Cid2 util.Uint64 `json:"cid2,omitempty"`
+	// Cid2Name 二级类目名称
+	Cid2Name string `json:"cid2Name,omitempty"`
+	// Cid3 三级类目ID
+	Cid3 util.Uint64 `json:"cid3,omitempty"`
+	// Cid3Name 三级类目名称
+	Cid3Name string `json:"cid3Name,omitempty"`
+}
+
+// CommissionInfo 京东商品佣金信息
+type CommissionInfo struct {
+	// Commission 佣金
+	Commission util.Float64 `json:"commission,omitempty"`
+	// CommissionShare 佣金比例
+	CommissionShare util.Float64 `json:"commissionShare,omitempty"`
+	// CouponCommission 券后佣金
+	CouponCommission util.Float64 `json:"couponCommission,omitempty"`
+	// PlusCommissionShare plus佣金比例
+	PlusCommissionShare util.Float64 `json:"plusCommissionShare,omitempty"`
+}
+
+// CouponInfo 京东商品优惠券信息
+type CouponInfo struct {
+	// CouponList 优惠券合集
+	CouponList []Coupon `json:"couponList,omitempty"`
+}
+
+// Best 最优优惠券，没有时返回nil
+func (c CouponInfo) Best() *Coupon {
+	for i := range c.CouponList {
+		if c.CouponList[i].IsBest == 1 {
+			return &c.CouponList[i]
+		}
+	}
+	if len(c.CouponList) > 0 {
+		return &c.CouponList[0]
+	}
+	return nil
+}
+
+// Coupon 京东优惠券
+type Coupon struct {
+	// BindType 券种类 (优惠券种类：0 - 全品类，1 - 限品类（自营商品），2 - 限店铺，3 - 店铺限商品券)
+	BindType int `json:"bindType,omitempty"`
+	// Discount 券面额
+	Discount util.Float64 `json:"discount,omitempty"`
+	// Link 券链接
+	Link string `json:"link,omitempty"`
+	// PlatformType 券使用平台 (平台类型：0 - 全平台券，1 - 限平台券)
+	PlatformType int `json:"platformType,omitempty"`
+	// Quota 券消费限额
+	Quota util.Float64 `json:"quota,omitempty"`
+	// GetStartTime 领取开始时间(时间戳，毫秒)
+	GetStartTime int64 `json:"getStartTime,omitempty"`
+	// GetEndTime 券领取结束时间(时间戳，毫秒)
+	GetEndTime int64 `json:"getEndTime,omitempty"`
+	// UseStartTime 券有效使用开始时间(时间戳，毫秒)
+	UseStartTime int64 `json:"useStartTime,omitempty"`
+	// UseEndTime 券有效使用结束时间(时间戳，毫秒)
+	UseEndTime int64 `json:"useEndTime,omitempty"`
+	// IsBest 最优优惠券，1：是；0：否
+	IsBest int `json:"isBest,omitempty"`
+}
+
+// ImageInfo 京东商品图片信息
+type ImageInfo struct {
+	// ImageList 图片合集
+	ImageList []struct {
+		// URL 图片链接地址，第一个图片链接为主图链接
+		URL string `json:"url,omitempty"`
+	} `json:"imageList,omitempty"`
+}
+
+// PriceInfo 京东商品价格信息
+type PriceInfo struct {
+	// Price 商品价格
+	Price util.Float64 `json:"price,omitempty"`
+	// LowestPrice 最低价格
+	LowestPrice util.Float64 `json:"lowestPrice,omitempty"`
+	// LowestPriceType 最低价格类型，1：无线价格；2：拼购价格； 3：秒杀价格
+	LowestPriceType int `json:"lowestPriceType,omitempty"`
+	// LowestCouponPrice 最低价后的优惠券价
+	LowestCouponPrice util.Float64 `json:"lowestCouponPrice,omitempty"`
+}
+
+// ShopInfo 京东店铺信息
+type ShopInfo struct {
+	// ShopID 店铺ID
+	ShopID util.Uint64 `json:"shopId,omitempty"`
+	// ShopName 店铺名称（或供应商名称）
+	ShopName string `json:"shopName,omitempty"`
+	// ShopLevel 店铺评分
+	ShopLevel util.Float64 `json:"shopLevel,omitempty"`
+}
+
+// GoodsList 京东商品列表分页结果
+type GoodsList struct {
+	// List 商品列表
+	List []Goods `json:"list,omitempty"`
+	// TotalNum 商品总数
+	TotalNum int64 `json:"totalNum,omitempty"`
+	// PageID 分页id
+	PageID string `json:"pageId,omitempty"`
+}
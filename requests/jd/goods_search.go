@@ -0,0 +1,118 @@
+package jd
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GoodsSearchRequest 京东联盟搜索 API Request
+type GoodsSearchRequest struct {
+	// Keyword 关键词
+	Keyword string `json:"keyword,omitempty"`
+	// PageID 页码，默认1
+	PageID int `json:"pageId,omitempty"`
+	// PageSize 每页数量，默认20，上限50
+	PageSize int `json:"pageSize,omitempty"`
+	// Cid1 一级类目id
+	Cid1 uint64 `json:"cid1,omitempty"`
+	// Cid2 二级类目id
+	Cid2 uint64 `json:"cid2,omitempty"`
+	// Cid3 三级类目id
+	Cid3 uint64 `json:"cid3,omitempty"`
+	// SkuIDs 商品ID，多个以英文逗号分隔
+	SkuIDs string `json:"skuIds,omitempty"`
+	// SortName 排序字段(price：单价, commissionShare：佣金比例, commission：佣金， inOrderCount30Days：30天引单量， inOrderComm30Days：30天支出佣金)
+	SortName string `json:"sortName,omitempty"`
+	// Sort asc,desc升降序,默认降序
+	Sort string `json:"sort,omitempty"`
+	// IsCoupon 是否是优惠券商品，1：有优惠券
+	IsCoupon int `json:"isCoupon,omitempty"`
+	// PriceFrom 商品券后价格下限
+	PriceFrom float64 `json:"pricefrom,omitempty"`
+	// PriceTo 商品券后价格上限
+	PriceTo float64 `json:"priceto,omitempty"`
+	// CommissionShareStart 佣金比例区间开始
+	CommissionShareStart int `json:"commissionShareStart,omitempty"`
+	// CommissionShareEnd 佣金比例区间结束
+	CommissionShareEnd int `json:"commissionShareEnd,omitempty"`
+	// Owner 商品类型：自营[g]，POP[p]
+	Owner string `json:"owner,omitempty"`
+	// IsHot 是否爆品，1：爆品
+	IsHot int `json:"isHot,omitempty"`
+	// BrandCode 品牌code
+	BrandCode string `json:"brandCode,omitempty"`
+	// ShopID 店铺Id
+	ShopID uint64 `json:"shopId,omitempty"`
+}
+
+// Values implement Request interface
+func (r GoodsSearchRequest) Values(values url.Values) {
+	if r.Keyword != "" {
+		values.Set("keyword", r.Keyword)
+	}
+	if r.PageID < 1 {
+		r.PageID = 1
+	}
+	values.Set("pageId", strconv.Itoa(r.PageID))
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.Cid1 > 0 {
+		values.Set("cid1", strconv.FormatUint(r.Cid1, 10))
+	}
+	if r.Cid2 > 0 {
+		values.Set("cid2", strconv.FormatUint(r.Cid2, 10))
+	}
+	if r.Cid3 > 0 {
+		values.Set("cid3", strconv.FormatUint(r.Cid3, 10))
+	}
+	if r.SkuIDs != "" {
+		values.Set("skuIds", r.SkuIDs)
+	}
+	if r.SortName != "" {
+		values.Set("sortName", r.SortName)
+	}
+	if r.Sort != "" {
+		values.Set("sort", r.Sort)
+	}
+	if r.IsCoupon == 1 {
+		values.Set("isCoupon", "1")
+	}
+	if r.PriceFrom > 1e-15 {
+		values.Set("pricefrom", strconv.FormatFloat(r.PriceFrom, 'f', 2, 64))
+	}
+	if r.PriceTo > 1e-15 {
+		values.Set("priceto", strconv.FormatFloat(r.PriceTo, 'f', 2, 64))
+	}
+	if r.CommissionShareStart > 0 {
+		values.Set("commissionShareStart", strconv.Itoa(r.CommissionShareStart))
+	}
+	if r.CommissionShareEnd > 0 {
+		values.Set("commissionShareEnd", strconv.Itoa(r.CommissionShareEnd))
+	}
+	if r.Owner != "" {
+		values.Set("owner", r.Owner)
+	}
+	if r.IsHot == 1 {
+		values.Set("isHot", "1")
+	}
+	if r.BrandCode != "" {
+		values.Set("brandCode", r.BrandCode)
+	}
+	if r.ShopID > 0 {
+		values.Set("shopId", strconv.FormatUint(r.ShopID, 10))
+	}
+}
+
+// Url implement Request interface
+func (r GoodsSearchRequest) Url() string {
+	return "dels/jd/goods/search"
+}
+
+// GoodsSearch 京东联盟搜索
+func GoodsSearch(clt *core.Client, req *GoodsSearchRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,69 @@
+package jd
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// PromotionUnionConvertRequest 京东商品转链 API Request
+type PromotionUnionConvertRequest struct {
+	// UnionID 京东联盟ID
+	UnionID string `json:"unionId,omitempty"`
+	// MaterialID 推广物料url，例如活动链接、商品链接等
+	MaterialID string `json:"materialId,omitempty"`
+	// PositionID 推广位id
+	PositionID string `json:"positionId,omitempty"`
+	// CouponURL 优惠券领取链接，在使用优惠券、商品二合一功能时入参，且materialId须为商品详情页链接
+	CouponURL string `json:"couponUrl,omitempty"`
+	// ChainType 转链类型，1：长链， 2 ：短链 ，3： 长链+短链，默认短链
+	ChainType int `json:"chainType,omitempty"`
+	// SubUnionID 子渠道标识
+	SubUnionID string `json:"subUnionId,omitempty"`
+	// Pid 联盟子推客身份标识（不能传入接口调用者自己的pid）
+	Pid string `json:"pid,omitempty"`
+}
+
+// Values implement Request interface
+func (r PromotionUnionConvertRequest) Values(values url.Values) {
+	values.Set("unionId", r.UnionID)
+	values.Set("materialId", r.MaterialID)
+	if r.PositionID != "" {
+		values.Set("positionId", r.PositionID)
+	}
+	if r.CouponURL != "" {
+		values.Set("couponUrl", r.CouponURL)
+	}
+	if r.ChainType > 0 {
+		values.Set("chainType", strconv.Itoa(r.ChainType))
+	}
+	if r.SubUnionID != "" {
+		values.Set("subUnionId", r.SubUnionID)
+	}
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+}
+
+// Url implement Request interface
+func (r PromotionUnionConvertRequest) Url() string {
+	return "dels/jd/kit/promotion-union-convert"
+}
+
+// PromotionLink 京东转链结果
+type PromotionLink struct {
+	// ShortURL 生成的推广目标链接，以短链接形式
+	ShortURL string `json:"shortUrl,omitempty"`
+	// ClickURL 生成推广目标链接，以长链接形式
+	ClickURL string `json:"clickURL,omitempty"`
+	// JCommand 京口令
+	JCommand string `json:"jCommand,omitempty"`
+	// JShortCommand 短口令
+	JShortCommand string `json:"jShortCommand,omitempty"`
+}
+
+// PromotionUnionConvert 京东商品转链
+func PromotionUnionConvert(clt *core.Client, req *PromotionUnionConvertRequest, ret *PromotionLink) error {
+	return clt.Get(req, ret)
+}
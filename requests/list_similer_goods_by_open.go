@@ -0,0 +1,56 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ListSimilerGoodsByOpenRequest 猜你喜欢 API Request
+type ListSimilerGoodsByOpenRequest struct {
+	// ID 大淘客的商品id
+	ID uint64 `json:"id,omitempty"`
+	// Size 每页条数，默认10，最大值100
+	Size int `json:"size,omitempty"`
+}
+
+// Values implement Request interface
+func (r ListSimilerGoodsByOpenRequest) Values(values url.Values) {
+	values.Set("id", strconv.FormatUint(r.ID, 10))
+	if r.Size > 0 {
+		values.Set("size", strconv.Itoa(r.Size))
+	}
+}
+
+// Url implement Request interface
+func (r ListSimilerGoodsByOpenRequest) Url() string {
+	return "goods/list-similer-goods-by-open"
+}
+
+// ListSimilerGoodsByOpen 猜你喜欢
+func ListSimilerGoodsByOpen(clt *core.Client, req *ListSimilerGoodsByOpenRequest, ret *[]GoodsDetail) error {
+	return clt.Get(req, ret)
+}
+
+// GoodsDetailWithSimilar 单品详情及猜你喜欢推荐商品
+type GoodsDetailWithSimilar struct {
+	GoodsDetail
+	// Similar 猜你喜欢推荐商品
+	Similar []GoodsDetail `json:"similar,omitempty"`
+}
+
+// GetGoodsDetailsWithSimilar 单品详情并附带猜你喜欢推荐商品；非大淘客平台商品（id=-1）不请求推荐商品
+func GetGoodsDetailsWithSimilar(clt *core.Client, req *GetGoodsDetailsRequest, size int, ret *GoodsDetailWithSimilar) error {
+	if err := GetGoodsDetails(clt, req, &ret.GoodsDetail); err != nil {
+		return err
+	}
+	if ret.ID <= 0 {
+		return nil
+	}
+	similarReq := ListSimilerGoodsByOpenRequest{
+		ID:   uint64(ret.ID),
+		Size: size,
+	}
+	return ListSimilerGoodsByOpen(clt, &similarReq, &ret.Similar)
+}
@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// LiveMaterialGoodsListRequest 直播好货 API Request
+type LiveMaterialGoodsListRequest struct {
+	// Date 日期，如：2020-10-13，默认当天
+	Date string `json:"date,omitempty"`
+	// Sort 排序方式，默认为0，0-综合排序，1-直播销量从高到低，2-直播时间从新到旧，3-佣金比例从高到低，4-价格（券后价）从高到低，5-价格（券后价）从低到高
+	Sort string `json:"sort,omitempty"`
+}
+
+// Values implement Request interface
+func (r LiveMaterialGoodsListRequest) Values(values url.Values) {
+	if r.Date != "" {
+		values.Set("date", r.Date)
+	}
+	if r.Sort != "" {
+		values.Set("sort", r.Sort)
+	}
+}
+
+// Url implement Request interface
+func (r LiveMaterialGoodsListRequest) Url() string {
+	return "goods/liveMaterial-goods-list"
+}
+
+// LiveMaterialGoods 直播好货商品
+type LiveMaterialGoods struct {
+	GoodsDetail
+	// LiveName 主播名称
+	LiveName string `json:"liveName,omitempty"`
+	// LiveAvatar 主播头像
+	LiveAvatar string `json:"liveAvatar,omitempty"`
+	// LiveTime 直播时间
+	LiveTime string `json:"liveTime,omitempty"`
+	// LiveSales 直播销量
+	LiveSales int64 `json:"liveSales,omitempty"`
+	// LiveURL 直播回放链接
+	LiveURL string `json:"liveUrl,omitempty"`
+}
+
+// GetLiveMaterialGoodsList 直播好货
+func GetLiveMaterialGoodsList(clt *core.Client, req *LiveMaterialGoodsListRequest, ret *[]LiveMaterialGoods) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,52 @@
+package meituan
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ActivityLinkRequest 美团领券活动转链 API Request
+type ActivityLinkRequest struct {
+	// ActID 美团联盟活动id，可在美团联盟活动列表获取
+	ActID string `json:"actId,omitempty"`
+	// Sid 推广位sid，支持通过接口自定义创建，不受平台推广位数量限制
+	Sid string `json:"sid,omitempty"`
+	// LinkType 链接类型，1-h5链接，2-deeplink，3-中间页唤起链接，4-微信小程序唤起路径
+	LinkType int `json:"linkType,omitempty"`
+}
+
+// Values implement Request interface
+func (r ActivityLinkRequest) Values(values url.Values) {
+	values.Set("actId", r.ActID)
+	if r.Sid != "" {
+		values.Set("sid", r.Sid)
+	}
+	if r.LinkType == 0 {
+		r.LinkType = 1
+	}
+	values.Set("linkType", strconv.Itoa(r.LinkType))
+}
+
+// Url implement Request interface
+func (r ActivityLinkRequest) Url() string {
+	return "dels/meituan/kit/activity-link"
+}
+
+// ActivityLink 美团活动推广链接
+type ActivityLink struct {
+	// Link 推广链接
+	Link string `json:"link,omitempty"`
+	// ShortLink 推广短链接
+	ShortLink string `json:"shortLink,omitempty"`
+	// MiniProgramPath 微信小程序路径
+	MiniProgramPath string `json:"miniProgramPath,omitempty"`
+	// MiniProgramAppID 微信小程序appId
+	MiniProgramAppID string `json:"miniProgramAppId,omitempty"`
+}
+
+// GetActivityLink 美团领券活动转链
+func GetActivityLink(clt *core.Client, req *ActivityLinkRequest, ret *ActivityLink) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,44 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// NineOpGoodsListRequest 9.9包邮精选 API Request
+type NineOpGoodsListRequest struct {
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为100，大于100按100处理
+	PageSize int `json:"pageSize,omitempty"`
+	// NineCid 9.9精选的类目id，分类id请求详情：-1-精选，1 -5.9元区，2 -9.9元区，3 -19.9元区，4 -29.9元区，5 -39.9元区
+	NineCid int `json:"nineCid,omitempty"`
+}
+
+// Values implement Request interface
+func (r NineOpGoodsListRequest) Values(values url.Values) {
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 100
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.NineCid == 0 {
+		r.NineCid = -1
+	}
+	values.Set("nineCid", strconv.Itoa(r.NineCid))
+}
+
+// Url implement Request interface
+func (r NineOpGoodsListRequest) Url() string {
+	return "goods/nine/op-goods-list"
+}
+
+// NineOpGoodsList 9.9包邮精选
+func NineOpGoodsList(clt *core.Client, req *NineOpGoodsListRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
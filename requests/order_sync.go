@@ -0,0 +1,62 @@
+package requests
+
+import (
+	"errors"
+	"time"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// SplitOrderWindows 将[start, end)按订单查询接口允许的最大时间窗口切分
+// 接口时间精确到秒且首尾均包含，因此每个窗口的结束时间为下一窗口开始时间的前一秒，相邻窗口不重叠
+func SplitOrderWindows(start time.Time, end time.Time) [][2]time.Time {
+	start = start.Truncate(time.Second)
+	end = end.Truncate(time.Second)
+	if !end.After(start) {
+		return nil
+	}
+	ret := make([][2]time.Time, 0, int(end.Sub(start)/OrderMaxWindow)+1)
+	for from := start; from.Before(end); from = from.Add(OrderMaxWindow) {
+		next := from.Add(OrderMaxWindow)
+		if next.After(end) {
+			next = end
+		}
+		ret = append(ret, [2]time.Time{from, next.Add(-time.Second)})
+	}
+	return ret
+}
+
+// ErrOrderPageLimit 单个时间窗口内翻页超过OrderMaxPageNo，剩余订单无法获取，应缩小时间窗口或增大PageSize
+var ErrOrderPageLimit = errors.New("order sync: page number exceeds OrderMaxPageNo")
+
+// SyncOrders 增量同步订单，将[start, end)切分为合规时间窗口，并在每个窗口内沿positionIndex翻页直至结束，每页订单回调fn，fn返回错误时中止同步
+func SyncOrders(clt *core.Client, req GetOrderDetailsRequest, start time.Time, end time.Time, fn func([]Order) error) error {
+	for _, window := range SplitOrderWindows(start, end) {
+		req.StartTime = window[0]
+		req.EndTime = window[1]
+		req.PositionIndex = ""
+		req.PageNo = 1
+		req.JumpType = 0
+		for {
+			var ret OrderDetails
+			if err := GetOrderDetails(clt, &req, &ret); err != nil {
+				return err
+			}
+			if orders := ret.Orders(); len(orders) > 0 {
+				if err := fn(orders); err != nil {
+					return err
+				}
+			}
+			if !ret.HasNext || ret.PositionIndex == "" {
+				break
+			}
+			if req.PageNo >= OrderMaxPageNo {
+				return ErrOrderPageLimit
+			}
+			req.PositionIndex = ret.PositionIndex
+			req.PageNo++
+			req.JumpType = 1
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package requests
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+func TestSplitOrderWindows(t *testing.T) {
+	base := time.Date(2022, 6, 1, 0, 0, 0, 0, time.Local)
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  [][2]time.Time
+	}{
+		{
+			name:  "empty",
+			start: base,
+			end:   base,
+		},
+		{
+			name:  "single window",
+			start: base,
+			end:   base.Add(time.Hour),
+			want:  [][2]time.Time{{base, base.Add(time.Hour - time.Second)}},
+		},
+		{
+			name:  "exact max window",
+			start: base,
+			end:   base.Add(OrderMaxWindow),
+			want:  [][2]time.Time{{base, base.Add(OrderMaxWindow - time.Second)}},
+		},
+		{
+			name:  "multiple windows",
+			start: base,
+			end:   base.Add(7 * time.Hour),
+			want: [][2]time.Time{
+				{base, base.Add(3*time.Hour - time.Second)},
+				{base.Add(3 * time.Hour), base.Add(6*time.Hour - time.Second)},
+				{base.Add(6 * time.Hour), base.Add(7*time.Hour - time.Second)},
+			},
+		},
+		{
+			name:  "sub-second precision",
+			start: base.Add(500 * time.Millisecond),
+			end:   base.Add(time.Hour + 500*time.Millisecond),
+			want:  [][2]time.Time{{base, base.Add(time.Hour - time.Second)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitOrderWindows(tt.start, tt.end)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d windows, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i, w := range got {
+				if !w[0].Equal(tt.want[i][0]) || !w[1].Equal(tt.want[i][1]) {
+					t.Errorf("window %d = %v, want %v", i, w, tt.want[i])
+				}
+				if w[1].Sub(w[0]) >= OrderMaxWindow {
+					t.Errorf("window %d exceeds OrderMaxWindow: %v", i, w)
+				}
+				if i > 0 && !w[0].After(got[i-1][1]) {
+					t.Errorf("window %d overlaps previous: %v %v", i, got[i-1], w)
+				}
+			}
+		})
+	}
+}
+
+func TestSyncOrders(t *testing.T) {
+	base := time.Date(2022, 6, 1, 0, 0, 0, 0, time.Local)
+	type call struct {
+		startTime     string
+		positionIndex string
+		jumpType      string
+		pageNo        string
+	}
+	tests := []struct {
+		name      string
+		end       time.Time
+		pages     int
+		stopAfter int
+		wantErr   error
+		wantCalls []call
+	}{
+		{
+			name:  "cursor across windows",
+			end:   base.Add(4 * time.Hour),
+			pages: 2,
+			wantCalls: []call{
+				{startTime: "2022-06-01 00:00:00", pageNo: "1"},
+				{startTime: "2022-06-01 00:00:00", positionIndex: "p1", jumpType: "1", pageNo: "2"},
+				{startTime: "2022-06-01 03:00:00", pageNo: "1"},
+				{startTime: "2022-06-01 03:00:00", positionIndex: "p1", jumpType: "1", pageNo: "2"},
+			},
+		},
+		{
+			name:      "callback error",
+			end:       base.Add(4 * time.Hour),
+			pages:     2,
+			stopAfter: 1,
+			wantErr:   errStop,
+			wantCalls: []call{
+				{startTime: "2022-06-01 00:00:00", pageNo: "1"},
+			},
+		},
+		{
+			name:    "page limit",
+			end:     base.Add(time.Hour),
+			pages:   OrderMaxPageNo + 1,
+			wantErr: ErrOrderPageLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []call
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				calls = append(calls, call{
+					startTime:     q.Get("startTime"),
+					positionIndex: q.Get("positionIndex"),
+					jumpType:      q.Get("jumpType"),
+					pageNo:        q.Get("pageNo"),
+				})
+				pageNo, _ := strconv.Atoi(q.Get("pageNo"))
+				ret := OrderDetails{
+					HasNext:       pageNo < tt.pages,
+					PositionIndex: "p" + strconv.Itoa(pageNo),
+				}
+				ret.Results.PublisherOrderDto = []Order{{TradeID: q.Get("startTime") + "#" + q.Get("pageNo")}}
+				json.NewEncoder(w).Encode(map[string]interface{}{"code": 0, "data": ret})
+			}))
+			defer srv.Close()
+			clt := core.NewClient("key", "secret")
+			clt.SetGateway(srv.URL + "/")
+			var got []string
+			err := SyncOrders(clt, GetOrderDetailsRequest{}, base, tt.end, func(orders []Order) error {
+				for _, o := range orders {
+					got = append(got, o.TradeID)
+				}
+				if tt.stopAfter > 0 && len(got) >= tt.stopAfter {
+					return errStop
+				}
+				return nil
+			})
+			if err != tt.wantErr {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantCalls != nil && !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %+v, want %+v", calls, tt.wantCalls)
+			}
+			if tt.wantErr == ErrOrderPageLimit && len(calls) != OrderMaxPageNo {
+				t.Errorf("len(calls) = %d, want %d", len(calls), OrderMaxPageNo)
+			}
+			if tt.wantErr == nil && len(got) != len(tt.wantCalls) {
+				t.Errorf("orders = %v, want one per page", got)
+			}
+		})
+	}
+}
+
+var errStop = errors.New("stop")
@@ -0,0 +1,44 @@
+package pdd
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// AuthorityQueryRequest 拼多多备案查询 API Request
+type AuthorityQueryRequest struct {
+	// Pid 推广位ID
+	Pid string `json:"pid,omitempty"`
+	// CustomParameters 自定义参数
+	CustomParameters string `json:"customParameters,omitempty"`
+}
+
+// Values implement Request interface
+func (r AuthorityQueryRequest) Values(values url.Values) {
+	values.Set("pid", r.Pid)
+	if r.CustomParameters != "" {
+		values.Set("customParameters", r.CustomParameters)
+	}
+}
+
+// Url implement Request interface
+func (r AuthorityQueryRequest) Url() string {
+	return "dels/pdd/kit/authority-query"
+}
+
+// Authority 拼多多备案查询结果
+type Authority struct {
+	// Bind 1-已备案，0-未备案
+	Bind int `json:"bind"`
+}
+
+// IsBind 是否已备案
+func (a Authority) IsBind() bool {
+	return a.Bind == 1
+}
+
+// AuthorityQuery 拼多多备案查询
+func AuthorityQuery(clt *core.Client, req *AuthorityQueryRequest, ret *Authority) error {
+	return clt.Get(req, ret)
+}
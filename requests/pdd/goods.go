@@ -0,0 +1,67 @@
+package pdd
+
+// Goods 拼多多商品信息，金额单位为分
+type Goods struct {
+	// GoodsSign 商品goodsSign，用于查询指定商品
+	GoodsSign string `json:"goodsSign,omitempty"`
+	// GoodsName 商品名称
+	GoodsName string `json:"goodsName,omitempty"`
+	// GoodsDesc 商品描述
+	GoodsDesc string `json:"goodsDesc,omitempty"`
+	// GoodsImageURL 多多进宝商品主图
+	GoodsImageURL string `json:"goodsImageUrl,omitempty"`
+	// GoodsThumbnailURL 商品缩略图
+	GoodsThumbnailURL string `json:"goodsThumbnailUrl,omitempty"`
+	// GoodsGalleryURLs 商品轮播图
+	GoodsGalleryURLs []string `json:"goodsGalleryUrls,omitempty"`
+	// MinGroupPrice 最小拼团价（单位为分）
+	MinGroupPrice int64 `json:"minGroupPrice,omitempty"`
+	// MinNormalPrice 最小单买价格（单位为分）
+	MinNormalPrice int64 `json:"minNormalPrice,omitempty"`
+	// HasCoupon 商品是否有优惠券
+	HasCoupon bool `json:"hasCoupon,omitempty"`
+	// CouponDiscount 优惠券面额,单位为分
+	CouponDiscount int64 `json:"couponDiscount,omitempty"`
+	// CouponMinOrderAmount 优惠券门槛价格,单位为分
+	CouponMinOrderAmount int64 `json:"couponMinOrderAmount,omitempty"`
+	// CouponStartTime 优惠券生效时间,UNIX时间戳
+	CouponStartTime int64 `json:"couponStartTime,omitempty"`
+	// CouponEndTime 优惠券失效时间,UNIX时间戳
+	CouponEndTime int64 `json:"couponEndTime,omitempty"`
+	// CouponRemainQuantity 优惠券剩余数量
+	CouponRemainQuantity int64 `json:"couponRemainQuantity,omitempty"`
+	// CouponTotalQuantity 优惠券总数量
+	CouponTotalQuantity int64 `json:"couponTotalQuantity,omitempty"`
+	// PromotionRate 佣金比例,千分比
+	PromotionRate int64 `json:"promotionRate,omitempty"`
+	// SalesTip 已售卖件数
+	SalesTip string `json:"salesTip,omitempty"`
+	// MallID 商家id
+	MallID uint64 `json:"mallId,omitempty"`
+	// MallName 店铺名字
+	MallName string `json:"mallName,omitempty"`
+	// MerchantType 店铺类型，1-个人，2-企业，3-旗舰店，4-专卖店，5-专营店，6-普通店
+	MerchantType int `json:"merchantType,omitempty"`
+	// OptID 商品标签ID
+	OptID uint64 `json:"optId,omitempty"`
+	// OptName 商品标签名
+	OptName string `json:"optName,omitempty"`
+	// CatIDs 商品类目id
+	CatIDs []uint64 `json:"catIds,omitempty"`
+	// SearchID 搜索id，建议生成推广链接时候填写，提高收益
+	SearchID string `json:"searchId,omitempty"`
+	// UnifiedTags 优势标签
+	UnifiedTags []string `json:"unifiedTags,omitempty"`
+}
+
+// GoodsList 拼多多商品列表分页结果
+type GoodsList struct {
+	// List 商品列表
+	List []Goods `json:"list,omitempty"`
+	// TotalCount 商品总数
+	TotalCount int64 `json:"totalCount,omitempty"`
+	// ListID 翻页时建议填写前页返回的listId值
+	ListID string `json:"listId,omitempty"`
+	// SearchID 搜索id
+	SearchID string `json:"searchId,omitempty"`
+}
@@ -0,0 +1,48 @@
+package pdd
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GoodsDetailRequest 拼多多商品详情 API Request
+type GoodsDetailRequest struct {
+	// GoodsSign 商品goodsSign
+	GoodsSign string `json:"goodsSign,omitempty"`
+	// Pid 推广位id
+	Pid string `json:"pid,omitempty"`
+	// CustomParameters 自定义参数，为链接打上自定义标签
+	CustomParameters string `json:"customParameters,omitempty"`
+	// SearchID 搜索id，建议填写，提高收益
+	SearchID string `json:"searchId,omitempty"`
+	// ZsDuoID 招商多多客ID
+	ZsDuoID string `json:"zsDuoId,omitempty"`
+}
+
+// Values implement Request interface
+func (r GoodsDetailRequest) Values(values url.Values) {
+	values.Set("goodsSign", r.GoodsSign)
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+	if r.CustomParameters != "" {
+		values.Set("customParameters", r.CustomParameters)
+	}
+	if r.SearchID != "" {
+		values.Set("searchId", r.SearchID)
+	}
+	if r.ZsDuoID != "" {
+		values.Set("zsDuoId", r.ZsDuoID)
+	}
+}
+
+// Url implement Request interface
+func (r GoodsDetailRequest) Url() string {
+	return "dels/pdd/goods/detail"
+}
+
+// GoodsDetail 拼多多商品详情
+func GoodsDetail(clt *core.Client, req *GoodsDetailRequest, ret *Goods) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,102 @@
+package pdd
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GoodsPromGenerateRequest 拼多多商品转链 API Request
+type GoodsPromGenerateRequest struct {
+	// Pid 推广位ID
+	Pid string `json:"pid,omitempty"`
+	// GoodsSign 商品goodsSign
+	GoodsSign string `json:"goodsSign,omitempty"`
+	// CustomParameters 自定义参数，为链接打上自定义标签
+	CustomParameters string `json:"customParameters,omitempty"`
+	// GenerateWeApp 是否生成拼多多小程序
+	GenerateWeApp bool `json:"generateWeApp,omitempty"`
+	// GenerateShortURL 是否生成短链接
+	GenerateShortURL bool `json:"generateShortUrl,omitempty"`
+	// GenerateSchemaURL 是否返回schema URL
+	GenerateSchemaURL bool `json:"generateSchemaUrl,omitempty"`
+	// MultiGroup true--生成多人团推广链接 false--生成单人团推广链接
+	MultiGroup bool `json:"multiGroup,omitempty"`
+	// SearchID 搜索id，建议填写，提高收益
+	SearchID string `json:"searchId,omitempty"`
+	// ZsDuoID 招商多多客ID
+	ZsDuoID string `json:"zsDuoId,omitempty"`
+}
+
+// Values implement Request interface
+func (r GoodsPromGenerateRequest) Values(values url.Values) {
+	values.Set("pid", r.Pid)
+	values.Set("goodsSign", r.GoodsSign)
+	if r.CustomParameters != "" {
+		values.Set("customParameters", r.CustomParameters)
+	}
+	if r.GenerateWeApp {
+		values.Set("generateWeApp", "true")
+	}
+	if r.GenerateShortURL {
+		values.Set("generateShortUrl", "true")
+	}
+	if r.GenerateSchemaURL {
+		values.Set("generateSchemaUrl", "true")
+	}
+	if r.MultiGroup {
+		values.Set("multiGroup", "true")
+	}
+	if r.SearchID != "" {
+		values.Set("searchId", r.SearchID)
+	}
+	if r.ZsDuoID != "" {
+		values.Set("zsDuoId", r.ZsDuoID)
+	}
+}
+
+// Url implement Request interface
+func (r GoodsPromGenerateRequest) Url() string {
+	return "dels/pdd/kit/goods-prom-generate"
+}
+
+// PromotionURL 拼多多推广链接
+type PromotionURL struct {
+	// URL 普通推广长链接
+	URL string `json:"url,omitempty"`
+	// ShortURL 普通推广短链接
+	ShortURL string `json:"shortUrl,omitempty"`
+	// MobileURL 唤醒拼多多app的推广长链接
+	MobileURL string `json:"mobileUrl,omitempty"`
+	// MobileShortURL 唤醒拼多多app的推广短链接
+	MobileShortURL string `json:"mobileShortUrl,omitempty"`
+	// SchemaURL schema链接，用于拉起拼多多app
+	SchemaURL string `json:"schemaUrl,omitempty"`
+	// WeAppInfo 拼多多小程序信息
+	WeAppInfo *WeAppInfo `json:"weAppInfo,omitempty"`
+}
+
+// WeAppInfo 拼多多小程序信息
+type WeAppInfo struct {
+	// AppID 小程序id
+	AppID string `json:"appId,omitempty"`
+	// PagePath 小程序path值
+	PagePath string `json:"pagePath,omitempty"`
+	// SourceDisplayName 来源名
+	SourceDisplayName string `json:"sourceDisplayName,omitempty"`
+	// Title 小程序标题
+	Title string `json:"title,omitempty"`
+	// UserName 用户名
+	UserName string `json:"userName,omitempty"`
+	// WeAppIconURL 小程序icon
+	WeAppIconURL string `json:"weAppIconUrl,omitempty"`
+	// BannerURL Banner图
+	BannerURL string `json:"bannerUrl,omitempty"`
+	// Desc 描述
+	Desc string `json:"desc,omitempty"`
+}
+
+// GoodsPromGenerate 拼多多商品转链
+func GoodsPromGenerate(clt *core.Client, req *GoodsPromGenerateRequest, ret *PromotionURL) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,93 @@
+package pdd
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GoodsSearchRequest 拼多多商品搜索 API Request
+type GoodsSearchRequest struct {
+	// Keyword 商品关键词
+	Keyword string `json:"keyword,omitempty"`
+	// Page 默认值1，商品分页数
+	Page int `json:"page,omitempty"`
+	// PageSize 默认100，每页商品数量
+	PageSize int `json:"pageSize,omitempty"`
+	// SortType 排序方式:0-综合排序;2-按佣金比例降序;3-按价格升序;4-按价格降序;6-按销量降序;9-券后价升序排序;10-券后价降序排序;12-按佣金金额降序排序;14-按佣金比例升序;16-店铺描述评分降序
+	SortType int `json:"sortType,omitempty"`
+	// WithCoupon 是否只返回优惠券的商品，false返回所有商品，true只返回有优惠券的商品
+	WithCoupon bool `json:"withCoupon,omitempty"`
+	// CatID 商品类目ID
+	CatID uint64 `json:"catId,omitempty"`
+	// OptID 商品标签类目ID
+	OptID uint64 `json:"optId,omitempty"`
+	// MerchantType 店铺类型，1-个人，2-企业，3-旗舰店，4-专卖店，5-专营店，6-普通店（未传为全部）
+	MerchantType int `json:"merchantType,omitempty"`
+	// IsBrandGoods 是否为品牌商品
+	IsBrandGoods bool `json:"isBrandGoods,omitempty"`
+	// ListID 翻页时建议填写前页返回的listId值
+	ListID string `json:"listId,omitempty"`
+	// Pid 推广位id
+	Pid string `json:"pid,omitempty"`
+	// CustomParameters 自定义参数，为链接打上自定义标签
+	CustomParameters string `json:"customParameters,omitempty"`
+	// GoodsSignList 商品goodsSign列表，多个以英文逗号分隔
+	GoodsSignList string `json:"goodsSignList,omitempty"`
+}
+
+// Values implement Request interface
+func (r GoodsSearchRequest) Values(values url.Values) {
+	if r.Keyword != "" {
+		values.Set("keyword", r.Keyword)
+	}
+	if r.Page < 1 {
+		r.Page = 1
+	}
+	values.Set("page", strconv.Itoa(r.Page))
+	if r.PageSize == 0 {
+		r.PageSize = 100
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.SortType > 0 {
+		values.Set("sortType", strconv.Itoa(r.SortType))
+	}
+	if r.WithCoupon {
+		values.Set("withCoupon", "true")
+	}
+	if r.CatID > 0 {
+		values.Set("catId", strconv.FormatUint(r.CatID, 10))
+	}
+	if r.OptID > 0 {
+		values.Set("optId", strconv.FormatUint(r.OptID, 10))
+	}
+	if r.MerchantType > 0 {
+		values.Set("merchantType", strconv.Itoa(r.MerchantType))
+	}
+	if r.IsBrandGoods {
+		values.Set("isBrandGoods", "true")
+	}
+	if r.ListID != "" {
+		values.Set("listId", r.ListID)
+	}
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+	if r.CustomParameters != "" {
+		values.Set("customParameters", r.CustomParameters)
+	}
+	if r.GoodsSignList != "" {
+		values.Set("goodsSignList", r.GoodsSignList)
+	}
+}
+
+// Url implement Request interface
+func (r GoodsSearchRequest) Url() string {
+	return "dels/pdd/goods/search"
+}
+
+// GoodsSearch 拼多多商品搜索
+func GoodsSearch(clt *core.Client, req *GoodsSearchRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
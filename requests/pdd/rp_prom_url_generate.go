@@ -0,0 +1,86 @@
+package pdd
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ChannelTypeAuthority 生成备案链接的channelType
+const ChannelTypeAuthority = 10
+
+// RpPromURLGenerateRequest 拼多多频道推广/备案链接生成 API Request
+type RpPromURLGenerateRequest struct {
+	// Pid 推广位ID
+	Pid string `json:"pid,omitempty"`
+	// ChannelType 营销工具类型，10-生成绑定备案链接
+	ChannelType int `json:"channelType,omitempty"`
+	// CustomParameters 自定义参数
+	CustomParameters string `json:"customParameters,omitempty"`
+	// GenerateWeApp 是否生成拼多多小程序
+	GenerateWeApp bool `json:"generateWeApp,omitempty"`
+	// GenerateShortURL 是否生成短链接
+	GenerateShortURL bool `json:"generateShortUrl,omitempty"`
+	// GenerateSchemaURL 是否返回schema URL
+	GenerateSchemaURL bool `json:"generateSchemaUrl,omitempty"`
+}
+
+// Values implement Request interface
+func (r RpPromURLGenerateRequest) Values(values url.Values) {
+	values.Set("pid", r.Pid)
+	values.Set("channelType", strconv.Itoa(r.ChannelType))
+	if r.CustomParameters != "" {
+		values.Set("customParameters", r.CustomParameters)
+	}
+	if r.GenerateWeApp {
+		values.Set("generateWeApp", "true")
+	}
+	if r.GenerateShortURL {
+		values.Set("generateShortUrl", "true")
+	}
+	if r.GenerateSchemaURL {
+		values.Set("generateSchemaUrl", "true")
+	}
+}
+
+// Url implement Request interface
+func (r RpPromURLGenerateRequest) Url() string {
+	return "dels/pdd/kit/rp-prom-url-generate"
+}
+
+// RpPromURLGenerate 拼多多频道推广/备案链接生成
+func RpPromURLGenerate(clt *core.Client, req *RpPromURLGenerateRequest, ret *PromotionURL) error {
+	return clt.Get(req, ret)
+}
+
+// AuthorityBackupResult 备案检查结果
+type AuthorityBackupResult struct {
+	// Authority 备案查询结果
+	Authority Authority `json:"authority"`
+	// PromotionURL 备案链接，仅未备案时存在
+	PromotionURL *PromotionURL `json:"promotionUrl,omitempty"`
+}
+
+// AuthorityBackup 查询pid+customParameters的备案状态，未备案时生成备案链接
+func AuthorityBackup(clt *core.Client, req *AuthorityQueryRequest, ret *AuthorityBackupResult) error {
+	if err := AuthorityQuery(clt, req, &ret.Authority); err != nil {
+		return err
+	}
+	if ret.Authority.IsBind() {
+		return nil
+	}
+	gen := RpPromURLGenerateRequest{
+		Pid:              req.Pid,
+		ChannelType:      ChannelTypeAuthority,
+		CustomParameters: req.CustomParameters,
+		GenerateWeApp:    true,
+		GenerateShortURL: true,
+	}
+	ret.PromotionURL = new(PromotionURL)
+	if err := RpPromURLGenerate(clt, &gen, ret.PromotionURL); err != nil {
+		ret.PromotionURL = nil
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// PriceTrendRequest 商品历史券后价 API Request
+type PriceTrendRequest struct {
+	// ID 大淘客商品id，请求时id或goodsId必填其中一个，若均填写，将优先查找当前单品id
+	ID uint64 `json:"id,omitempty"`
+	// GoodsID 淘宝商品id，id或goodsId必填其中一个，若均填写，将优先查找当前单品id
+	GoodsID string `json:"goodsId,omitempty"`
+}
+
+// Values implement Request interface
+func (r PriceTrendRequest) Values(values url.Values) {
+	if r.ID > 0 {
+		values.Set("id", strconv.FormatUint(r.ID, 10))
+	}
+	if r.GoodsID != "" {
+		values.Set("goodsId", r.GoodsID)
+	}
+}
+
+// Url implement Request interface
+func (r PriceTrendRequest) Url() string {
+	return "goods/price-trend"
+}
+
+// PriceTrend 商品价格趋势
+type PriceTrend struct {
+	GoodsDetail
+	// HistoricalPrice 每日价格记录
+	HistoricalPrice []HistoricalPrice `json:"historicalPrice,omitempty"`
+}
+
+// HistoricalPrice 每日价格记录
+type HistoricalPrice struct {
+	// Date 日期，如：2020-06-01
+	Date string `json:"date,omitempty"`
+	// ActualPrice 当天券后价
+	ActualPrice float64 `json:"actualPrice,omitempty"`
+	// OriginalPrice 当天原价
+	OriginalPrice float64 `json:"originalPrice,omitempty"`
+	// CouponPrice 当天优惠券金额
+	CouponPrice float64 `json:"couponPrice,omitempty"`
+}
+
+// LowestPrice 价格记录中券后价最低的一天，没有记录时返回false
+func (p PriceTrend) LowestPrice() (HistoricalPrice, bool) {
+	var (
+		ret   HistoricalPrice
+		found bool
+	)
+	for _, v := range p.HistoricalPrice {
+		if v.ActualPrice <= 0 {
+			continue
+		}
+		if !found || v.ActualPrice < ret.ActualPrice {
+			ret = v
+			found = true
+		}
+	}
+	return ret, found
+}
+
+// GetPriceTrend 商品历史券后价
+func GetPriceTrend(clt *core.Client, req *PriceTrendRequest, ret *PriceTrend) error {
+	return clt.Get(req, ret)
+}
@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// SuperDiscountGoodsRequest 折上折 API Request
+type SuperDiscountGoodsRequest struct {
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为100，最大值200，若小于10，则按10条处理，每页条数仅支持输入10,50,100,200
+	PageSize int `json:"pageSize,omitempty"`
+	// Sort 排序方式，默认为0，0-综合排序，1-商品上架时间从新到旧，2-销量从高到低，3-领券量从高到低，4-佣金比例从高到低，5-价格（券后价）从高到低，6-价格（券后价）从低到高
+	Sort string `json:"sort,omitempty"`
+	// Cids 大淘客的一级分类id，如果需要传多个，以英文逗号相隔，如：”1,2,3”
+	Cids string `json:"cids,omitempty"`
+}
+
+// Values implement Request interface
+func (r SuperDiscountGoodsRequest) Values(values url.Values) {
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 100
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.Sort != "" {
+		values.Set("sort", r.Sort)
+	}
+	if r.Cids != "" {
+		values.Set("cids", r.Cids)
+	}
+}
+
+// Url implement Request interface
+func (r SuperDiscountGoodsRequest) Url() string {
+	return "goods/super-discount-goods"
+}
+
+// SuperDiscountGoods 折上折
+func SuperDiscountGoods(clt *core.Client, req *SuperDiscountGoodsRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
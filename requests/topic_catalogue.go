@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// TopicCatalogueRequest 精选专题 API Request
+type TopicCatalogueRequest struct{}
+
+// Values implement Request interface
+func (r TopicCatalogueRequest) Values(values url.Values) {}
+
+// Url implement Request interface
+func (r TopicCatalogueRequest) Url() string {
+	return "goods/topic/catalogue"
+}
+
+// Topic 精选专题
+type Topic struct {
+	// TopicID 专题id，用于专题商品接口
+	TopicID uint64 `json:"topicId,omitempty"`
+	// TopicName 专题名称
+	TopicName string `json:"topicName,omitempty"`
+	// Banner 专题banner图
+	Banner []string `json:"banner,omitempty"`
+	// StartTime 专题开始时间
+	StartTime string `json:"startTime,omitempty"`
+	// EndTime 专题结束时间
+	EndTime string `json:"endTime,omitempty"`
+	// CreateTime 专题创建时间
+	CreateTime string `json:"createTime,omitempty"`
+	// Type 专题类型，1-常规专题，2-活动专题
+	Type int `json:"type,omitempty"`
+	// ActivityID 专题活动id
+	ActivityID string `json:"activityId,omitempty"`
+	// Link 专题活动链接
+	Link string `json:"link,omitempty"`
+}
+
+// TopicCatalogue 精选专题
+func TopicCatalogue(clt *core.Client, ret *[]Topic) error {
+	return clt.Get(TopicCatalogueRequest{}, ret)
+}
@@ -0,0 +1,41 @@
+package requests
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// TopicGoodsListRequest 专题商品 API Request
+type TopicGoodsListRequest struct {
+	// TopicID 通过精选专题API获取的专题id
+	TopicID uint64 `json:"topicId,omitempty"`
+	// PageID 分页id，常规分页方式，请直接传入对应页码（比如：1,2,3……）
+	PageID string `json:"pageId,omitempty"`
+	// PageSize 每页条数，默认为100，大于100按100处理
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// Values implement Request interface
+func (r TopicGoodsListRequest) Values(values url.Values) {
+	values.Set("topicId", strconv.FormatUint(r.TopicID, 10))
+	if r.PageID == "" {
+		r.PageID = "1"
+	}
+	values.Set("pageId", r.PageID)
+	if r.PageSize == 0 {
+		r.PageSize = 100
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+}
+
+// Url implement Request interface
+func (r TopicGoodsListRequest) Url() string {
+	return "goods/topic/goods-list"
+}
+
+// TopicGoodsList 专题商品
+func TopicGoodsList(clt *core.Client, req *TopicGoodsListRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
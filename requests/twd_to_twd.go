@@ -0,0 +1,53 @@
+package requests
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// TwdToTwdRequest 淘口令转淘口令 API Request
+type TwdToTwdRequest struct {
+	// Content 支持包含文本的淘口令，但最好是一个单独淘口令
+	Content string `json:"content,omitempty"`
+	// Pid 推广位ID，用户可自由填写当前大淘客账号下已授权淘宝账号的任一pid，若未填写，则默认使用创建应用时绑定的pid
+	Pid string `json:"pid,omitempty"`
+	// ChannelID 渠道id将会和传入的pid进行验证，验证通过将正常转链，请确认填入的渠道id是正确的 channelId对应联盟的relationId
+	ChannelID string `json:"channelId,omitempty"`
+	// Special 会员运营ID
+	Special string `json:"special,omitempty"`
+	// External 淘宝客外部用户标记，如自身系统账户ID；微信ID等
+	External string `json:"external,omitempty"`
+	// AuthID 平台的淘宝授权id，如果传入了该参数则必须填写对应淘宝联盟授权账号的pid
+	AuthID string `json:"authId,omitempty"`
+}
+
+// Values implement Request interface
+func (r TwdToTwdRequest) Values(values url.Values) {
+	values.Set("content", r.Content)
+	if r.Pid != "" {
+		values.Set("pid", r.Pid)
+	}
+	if r.ChannelID != "" {
+		values.Set("channelId", r.ChannelID)
+	}
+	if r.Special != "" {
+		values.Set("special", r.Special)
+	}
+	if r.External != "" {
+		values.Set("external", r.External)
+	}
+	if r.AuthID != "" {
+		values.Set("authId", r.AuthID)
+	}
+}
+
+// Url implement Request interface
+func (r TwdToTwdRequest) Url() string {
+	return "tb-service/twd-to-twd"
+}
+
+// TwdToTwd 淘口令转淘口令
+func TwdToTwd(clt *core.Client, req *TwdToTwdRequest, ret *PrivilegeLink) error {
+	return clt.Get(req, ret)
+}
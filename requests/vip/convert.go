@@ -0,0 +1,54 @@
+package vip
+
+import (
+	"net/url"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// ConvertRequest 唯品会转链 API Request
+type ConvertRequest struct {
+	// URLList 唯品会商品链接或商品id，多个以英文逗号分隔
+	URLList string `json:"urlList,omitempty"`
+	// ChanTag 自定义渠道标识
+	ChanTag string `json:"chanTag,omitempty"`
+	// OpenID 用户在唯品会的openId，不传则为大淘客授权账号
+	OpenID string `json:"openId,omitempty"`
+}
+
+// Values implement Request interface
+func (r ConvertRequest) Values(values url.Values) {
+	values.Set("urlList", r.URLList)
+	if r.ChanTag != "" {
+		values.Set("chanTag", r.ChanTag)
+	}
+	if r.OpenID != "" {
+		values.Set("openId", r.OpenID)
+	}
+}
+
+// Url implement Request interface
+func (r ConvertRequest) Url() string {
+	return "dels/vip/kit/convert"
+}
+
+// Link 唯品会转链结果
+type Link struct {
+	// Source 原始链接或商品id
+	Source string `json:"source,omitempty"`
+	// URL 推广长链接
+	URL string `json:"url,omitempty"`
+	// LongURL 推广长链接（带跳转）
+	LongURL string `json:"longUrl,omitempty"`
+	// DeepLinkURL 唤起唯品会app的deeplink
+	DeepLinkURL string `json:"deeplinkUrl,omitempty"`
+	// TraFromURL 微信小程序推广链接
+	TraFromURL string `json:"traFromUrl,omitempty"`
+	// VipWxURL 唯品会小程序path
+	VipWxURL string `json:"vipWxUrl,omitempty"`
+}
+
+// Convert 唯品会转链
+func Convert(clt *core.Client, req *ConvertRequest, ret *[]Link) error {
+	return clt.Get(req, ret)
+}
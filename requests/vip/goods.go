@@ -0,0 +1,55 @@
+package vip
+
+import "github.com/bububa/dataoke-go/util"
+
+// Goods 唯品会商品信息
+type Goods struct {
+	// GoodsID 商品id
+	GoodsID string `json:"goodsId,omitempty"`
+	// GoodsName 商品名称
+	GoodsName string `json:"goodsName,omitempty"`
+	// GoodsDesc 商品描述
+	GoodsDesc string `json:"goodsDesc,omitempty"`
+	// DestURL 商品落地页
+	DestURL string `json:"destUrl,omitempty"`
+	// GoodsMainPicture 商品主图
+	GoodsMainPicture string `json:"goodsMainPicture,omitempty"`
+	// GoodsThumbURL 商品缩略图
+	GoodsThumbURL string `json:"goodsThumbUrl,omitempty"`
+	// GoodsCarouselPictures 商品轮播图
+	GoodsCarouselPictures []string `json:"goodsCarouselPictures,omitempty"`
+	// CategoryID 商品分类id
+	CategoryID uint64 `json:"categoryId,omitempty"`
+	// CategoryName 商品分类名称
+	CategoryName string `json:"categoryName,omitempty"`
+	// BrandName 品牌名称
+	BrandName string `json:"brandName,omitempty"`
+	// BrandLogoFull 品牌logo
+	BrandLogoFull string `json:"brandLogoFull,omitempty"`
+	// MarketPrice 市场价（元）
+	MarketPrice util.Float64 `json:"marketPrice,omitempty"`
+	// VipPrice 唯品价（元）
+	VipPrice util.Float64 `json:"vipPrice,omitempty"`
+	// Discount 折扣
+	Discount util.Float64 `json:"discount,omitempty"`
+	// CommissionRate 佣金比例（%）
+	CommissionRate util.Float64 `json:"commissionRate,omitempty"`
+	// Commission 佣金金额（元）
+	Commission util.Float64 `json:"commission,omitempty"`
+	// Status 商品售卖状态，0-在售，1-售罄
+	Status int `json:"status,omitempty"`
+	// SchemeStartTime 推广开始时间，毫秒时间戳
+	SchemeStartTime int64 `json:"schemeStartTime,omitempty"`
+	// SchemeEndTime 推广结束时间，毫秒时间戳
+	SchemeEndTime int64 `json:"schemeEndTime,omitempty"`
+}
+
+// GoodsList 唯品会商品列表分页结果
+type GoodsList struct {
+	// List 商品列表
+	List []Goods `json:"list,omitempty"`
+	// Total 商品总数
+	Total int64 `json:"total,omitempty"`
+	// Page 当前页码
+	Page int `json:"page,omitempty"`
+}
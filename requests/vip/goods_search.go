@@ -0,0 +1,61 @@
+package vip
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/bububa/dataoke-go/core"
+)
+
+// GoodsSearchRequest 唯品会商品搜索 API Request
+type GoodsSearchRequest struct {
+	// Keyword 关键词
+	Keyword string `json:"keyword,omitempty"`
+	// Page 页码，默认1
+	Page int `json:"page,omitempty"`
+	// PageSize 每页数量，默认20，最大50
+	PageSize int `json:"pageSize,omitempty"`
+	// FieldName 排序字段：PRICE-价格，DISCOUNT-折扣，COMMISSION-佣金，COMM_RATIO-佣金比例，SALES-销量
+	FieldName string `json:"fieldName,omitempty"`
+	// Order 排序顺序：0-正序，1-逆序，默认正序
+	Order int `json:"order,omitempty"`
+	// PriceStart 价格区间开始
+	PriceStart float64 `json:"priceStart,omitempty"`
+	// PriceEnd 价格区间结束
+	PriceEnd float64 `json:"priceEnd,omitempty"`
+}
+
+// Values implement Request interface
+func (r GoodsSearchRequest) Values(values url.Values) {
+	values.Set("keyword", r.Keyword)
+	if r.Page < 1 {
+		r.Page = 1
+	}
+	values.Set("page", strconv.Itoa(r.Page))
+	if r.PageSize == 0 {
+		r.PageSize = 20
+	}
+	values.Set("pageSize", strconv.Itoa(r.PageSize))
+	if r.FieldName != "" {
+		values.Set("fieldName", r.FieldName)
+	}
+	if r.Order == 1 {
+		values.Set("order", "1")
+	}
+	if r.PriceStart > 1e-15 {
+		values.Set("priceStart", strconv.FormatFloat(r.PriceStart, 'f', 2, 64))
+	}
+	if r.PriceEnd > 1e-15 {
+		values.Set("priceEnd", strconv.FormatFloat(r.PriceEnd, 'f', 2, 64))
+	}
+}
+
+// Url implement Request interface
+func (r GoodsSearchRequest) Url() string {
+	return "dels/vip/goods/search"
+}
+
+// GoodsSearch 唯品会商品搜索
+func GoodsSearch(clt *core.Client, req *GoodsSearchRequest, ret *GoodsList) error {
+	return clt.Get(req, ret)
+}
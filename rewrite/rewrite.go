@@ -0,0 +1,307 @@
+package rewrite
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/bububa/dataoke-go/core"
+	"github.com/bububa/dataoke-go/parse"
+	"github.com/bububa/dataoke-go/requests"
+)
+
+// ErrNotGoods 淘系万能解析结果既不是商品也不是活动会场
+var ErrNotGoods = errors.New("parsed content is not a goods")
+
+// ErrEmptyLink 高效转链未返回可替换的淘口令或链接
+var ErrEmptyLink = errors.New("privilege link has no tpwd or url")
+
+// DefaultConcurrency 默认并发请求数
+const DefaultConcurrency = 5
+
+// Segment 文本中一个淘口令或链接的转换结果
+type Segment struct {
+	// Token 本地识别出的片段
+	Token parse.Token
+	// GoodsID 淘宝商品id
+	GoodsID string
+	// CouponID 淘系万能解析得到的原文优惠券id，转链时传入以保留该优惠券
+	CouponID string
+	// Link 高效转链结果
+	Link *requests.PrivilegeLink
+	// Activity 活动会场转链结果，仅片段解析为活动会场时存在
+	Activity *requests.ActivityLink
+	// Replacement 替换后的内容，转换失败时为空
+	Replacement string
+	// Err 转换失败原因
+	Err error
+}
+
+// Result 文本转换结果
+type Result struct {
+	// Text 替换后的文本，转换失败的片段保留原文
+	Text string
+	// Segments 按出现顺序排列的全部片段
+	Segments []Segment
+}
+
+// Failed 转换失败的片段
+func (r Result) Failed() []Segment {
+	var ret []Segment
+	for _, s := range r.Segments {
+		if s.Err != nil {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+// Rewriter 将文本中的淘口令、淘系链接全部替换为自己的推广淘口令或短链接
+type Rewriter struct {
+	clt         *core.Client
+	parser      *parse.Parser
+	link        requests.GetPrivilegeLinkRequest
+	activity    requests.ActivityLinkRequest
+	concurrency int
+}
+
+// NewRewriter returns a Rewriter instance, link为高效转链请求模板，GoodsID、CouponID由解析结果填充；活动会场转链默认使用link的Pid及ChannelID
+func NewRewriter(clt *core.Client, link requests.GetPrivilegeLinkRequest) *Rewriter {
+	parser := parse.NewParser()
+	if link.LeftSymbol != "" && link.RightSymbol != "" {
+		parser.SetSymbols(link.LeftSymbol, link.RightSymbol)
+	}
+	return &Rewriter{
+		clt:    clt,
+		parser: parser,
+		link:   link,
+		activity: requests.ActivityLinkRequest{
+			Pid:        link.Pid,
+			RelationID: link.ChannelID,
+		},
+		concurrency: DefaultConcurrency,
+	}
+}
+
+// SetActivityRequest set custom 官方活动会场转链请求模板 for Rewriter, PromotionSceneID由解析结果填充
+func (r *Rewriter) SetActivityRequest(req requests.ActivityLinkRequest) {
+	r.activity = req
+}
+
+// SetParser set custom parse.Parser for Rewriter
+func (r *Rewriter) SetParser(parser *parse.Parser) {
+	r.parser = parser
+}
+
+// SetConcurrency set max concurrent api requests for Rewriter
+func (r *Rewriter) SetConcurrency(concurrency int) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	r.concurrency = concurrency
+}
+
+// Rewrite 本地识别文本中的淘口令及链接，对无法直接取到商品id的片段调用淘系万能解析（活动会场直接转链），再按商品id及优惠券id去重调用高效转链，最后将结果替换回原文
+func (r *Rewriter) Rewrite(text string) *Result {
+	tokens := r.parser.Parse(text)
+	ret := &Result{
+		Text:     text,
+		Segments: make([]Segment, len(tokens)),
+	}
+	if len(tokens) == 0 {
+		return ret
+	}
+	for i, t := range tokens {
+		ret.Segments[i].Token = t
+		ret.Segments[i].GoodsID = t.GoodsID
+	}
+	r.resolveGoodsIDs(ret.Segments)
+	r.convertLinks(ret.Segments)
+	ret.Text = render(text, ret.Segments)
+	return ret
+}
+
+// resolveGoodsIDs 按片段原文去重调用淘系万能解析，解析为活动会场的片段直接调用官方活动会场转链
+func (r *Rewriter) resolveGoodsIDs(segments []Segment) {
+	type resolved struct {
+		goodsID  string
+		couponID string
+		activity *requests.ActivityLink
+		err      error
+	}
+	pending := make(map[string]*resolved)
+	for _, s := range segments {
+		if s.GoodsID == "" {
+			pending[s.Token.Text] = nil
+		}
+	}
+	var mu sync.Mutex
+	r.each(keys(pending), func(content string) {
+		var (
+			parsed requests.ParseContentResult
+			res    resolved
+		)
+		if err := requests.ParseContent(r.clt, content, &parsed); err != nil {
+			res.err = err
+		} else if parsed.DataType == "activity" {
+			res.activity = new(requests.ActivityLink)
+			if res.err = requests.ConvertParsedActivity(r.clt, &parsed, r.activity, res.activity); res.err != nil {
+				res.activity = nil
+			}
+		} else {
+			if res.goodsID = parsed.GoodsID; res.goodsID == "" {
+				res.goodsID = parsed.ItemID
+			}
+			if parsed.OriginInfo != nil {
+				res.couponID = parsed.OriginInfo.ActitivyID
+			}
+			if res.goodsID == "" {
+				res.err = ErrNotGoods
+			}
+		}
+		mu.Lock()
+		pending[content] = &res
+		mu.Unlock()
+	})
+	for i := range segments {
+		s := &segments[i]
+		res, ok := pending[s.Token.Text]
+		if !ok || res == nil {
+			continue
+		}
+		s.GoodsID = res.goodsID
+		s.CouponID = res.couponID
+		s.Err = res.err
+		if s.Activity = res.activity; s.Activity != nil {
+			if s.Replacement = activityReplacement(s.Token, s.Activity); s.Replacement == "" {
+				s.Err = ErrEmptyLink
+			}
+		}
+	}
+}
+
+// convertLinks 按商品id及优惠券id去重调用高效转链
+func (r *Rewriter) convertLinks(segments []Segment) {
+	type converted struct {
+		link *requests.PrivilegeLink
+		err  error
+	}
+	pending := make(map[string]*converted)
+	for _, s := range segments {
+		if s.Err == nil && s.GoodsID != "" {
+			pending[linkKey(&s)] = nil
+		}
+	}
+	var mu sync.Mutex
+	r.each(keys(pending), func(key string) {
+		req := r.link
+		req.GoodsID, req.CouponID, _ = strings.Cut(key, "\x00")
+		if req.CouponID == "" {
+			req.CouponID = r.link.CouponID
+		}
+		res := converted{link: new(requests.PrivilegeLink)}
+		if err := requests.GetPrivilageLink(r.clt, &req, res.link); err != nil {
+			res.link = nil
+			res.err = err
+		}
+		mu.Lock()
+		pending[key] = &res
+		mu.Unlock()
+	})
+	for i := range segments {
+		s := &segments[i]
+		res, ok := pending[linkKey(s)]
+		if s.Err != nil || !ok || res == nil {
+			continue
+		}
+		if res.err != nil {
+			s.Err = res.err
+			continue
+		}
+		s.Link = res.link
+		if s.Replacement = replacement(s.Token, res.link); s.Replacement == "" {
+			s.Err = ErrEmptyLink
+		}
+	}
+}
+
+// linkKey 高效转链去重key，同一商品不同优惠券分别转链
+func linkKey(s *Segment) string {
+	return s.GoodsID + "\x00" + s.CouponID
+}
+
+func (r *Rewriter) each(items []string, fn func(string)) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, r.concurrency)
+	for _, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(item string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(item)
+		}(item)
+	}
+	wg.Wait()
+}
+
+// replacement 淘口令替换为淘口令，链接替换为短链接，缺失时互为兜底
+func replacement(token parse.Token, link *requests.PrivilegeLink) string {
+	candidates := []string{link.ShortURL, link.Tpwd, link.CouponClickURL, link.ItemURL}
+	if token.Kind.IsTpwd() {
+		candidates = []string{link.Tpwd, link.ShortURL, link.CouponClickURL, link.ItemURL}
+		if token.Kind == parse.KindLongTpwd && link.LongTpwd != "" {
+			candidates[0] = link.LongTpwd
+		}
+	}
+	for _, v := range candidates {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// activityReplacement 活动会场淘口令替换为淘口令，链接替换为短链接，缺失时互为兜底
+func activityReplacement(token parse.Token, link *requests.ActivityLink) string {
+	candidates := []string{link.ShortClickURL, link.ClickURL, link.Tpwd}
+	if token.Kind.IsTpwd() {
+		candidates = []string{link.Tpwd, link.ShortClickURL, link.ClickURL}
+		if token.Kind == parse.KindLongTpwd && link.LongTpwd != "" {
+			candidates[0] = link.LongTpwd
+		}
+	}
+	for _, v := range candidates {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func render(text string, segments []Segment) string {
+	var (
+		offset int
+		buf    = make([]byte, 0, len(text))
+	)
+	for _, s := range segments {
+		if s.Err != nil || s.Replacement == "" {
+			continue
+		}
+		buf = append(buf, text[offset:s.Token.Start]...)
+		buf = append(buf, s.Replacement...)
+		offset = s.Token.End
+	}
+	buf = append(buf, text[offset:]...)
+	return string(buf)
+}
+
+func keys[T any](m map[string]T) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	return ret
+}
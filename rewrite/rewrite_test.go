@@ -0,0 +1,179 @@
+package rewrite
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bububa/dataoke-go/core"
+	"github.com/bububa/dataoke-go/requests"
+)
+
+type fakeServer struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (s *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var (
+		key  string
+		data interface{}
+	)
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/tb-service/parse-content"):
+		content := query.Get("content")
+		key = "parse:" + content
+		switch content {
+		case "￥AbCdEfGhIjK￥":
+			data = requests.ParseContentResult{GoodsID: "100", DataType: "goods"}
+		case "￥ZzZzZzZzZzZ￥":
+			data = requests.ParseContentResult{GoodsID: "200", DataType: "goods"}
+		case "￥AcTiViTy001￥":
+			data = requests.ParseContentResult{ItemID: "999", DataType: "activity"}
+		case "￥CoUpOn00001￥":
+			data = requests.ParseContentResult{GoodsID: "100", DataType: "goods", OriginInfo: &requests.OriginInfo{ActitivyID: "c1"}}
+		}
+	case strings.HasSuffix(r.URL.Path, "/tb-service/activity-link"):
+		sceneID := query.Get("promotionSceneId")
+		key = "activity:" + sceneID + ":" + query.Get("pid")
+		if sceneID == "999" {
+			data = requests.ActivityLink{Tpwd: "￥Act999￥", ShortClickURL: "https://s.click.taobao.com/act999"}
+		}
+	case strings.HasSuffix(r.URL.Path, "/tb-service/get-privilege-link"):
+		goodsID := query.Get("goodsId")
+		key = "link:" + goodsID
+		if couponID := query.Get("couponId"); couponID != "" {
+			key += ":" + couponID
+		}
+		switch key {
+		case "link:100":
+			data = requests.PrivilegeLink{ItemID: "100", Tpwd: "￥NewTpwd100￥", ShortURL: "https://m.tb.cn/h.new100"}
+		case "link:100:c1":
+			data = requests.PrivilegeLink{ItemID: "100", Tpwd: "￥Coupon100￥"}
+		}
+	}
+	s.mu.Lock()
+	s.calls[key]++
+	s.mu.Unlock()
+	resp := map[string]interface{}{"code": 0, "msg": "成功", "data": data}
+	if data == nil {
+		resp = map[string]interface{}{"code": -1, "msg": "failed"}
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func TestRewriterRewrite(t *testing.T) {
+	srv := &fakeServer{calls: make(map[string]int)}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	clt := core.NewClient("key", "secret")
+	clt.SetGateway(ts.URL + "/")
+
+	text := "A ￥AbCdEfGhIjK￥ B ￥AbCdEfGhIjK￥ C https://item.taobao.com/item.htm?id=100 D ￥ZzZzZzZzZzZ￥ E https://m.tb.cn/h.fail打开"
+	r := NewRewriter(clt, requests.GetPrivilegeLinkRequest{Pid: "mm_1_2_3"})
+	r.SetConcurrency(2)
+	ret := r.Rewrite(text)
+
+	want := "A ￥NewTpwd100￥ B ￥NewTpwd100￥ C https://m.tb.cn/h.new100 D ￥ZzZzZzZzZzZ￥ E https://m.tb.cn/h.fail打开"
+	if ret.Text != want {
+		t.Errorf("Text = %q, want %q", ret.Text, want)
+	}
+	if len(ret.Segments) != 5 {
+		t.Fatalf("len(Segments) = %d, want 5", len(ret.Segments))
+	}
+	for _, s := range ret.Segments {
+		if text[s.Token.Start:s.Token.End] != s.Token.Text {
+			t.Errorf("segment offsets [%d:%d] = %q, want %q", s.Token.Start, s.Token.End, text[s.Token.Start:s.Token.End], s.Token.Text)
+		}
+	}
+	failed := ret.Failed()
+	if len(failed) != 2 {
+		t.Fatalf("len(Failed) = %d, want 2", len(failed))
+	}
+	if failed[0].Token.Text != "￥ZzZzZzZzZzZ￥" || failed[0].GoodsID != "200" || failed[0].Err == nil {
+		t.Errorf("Failed[0] = %+v", failed[0])
+	}
+	if failed[1].Token.Text != "https://m.tb.cn/h.fail" || failed[1].Err == nil {
+		t.Errorf("Failed[1] = %+v", failed[1])
+	}
+	// 相同片段只解析一次，相同商品只转链一次，商品链接不需要解析
+	wantCalls := map[string]int{
+		"parse:￥AbCdEfGhIjK￥":          1,
+		"parse:￥ZzZzZzZzZzZ￥":          1,
+		"parse:https://m.tb.cn/h.fail": 1,
+		"link:100":                     1,
+		"link:200":                     1,
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	for k, v := range wantCalls {
+		if srv.calls[k] != v {
+			t.Errorf("calls[%q] = %d, want %d", k, srv.calls[k], v)
+		}
+	}
+	if len(srv.calls) != len(wantCalls) {
+		t.Errorf("calls = %v, want %v", srv.calls, wantCalls)
+	}
+}
+
+func TestRewriterActivityAndCoupon(t *testing.T) {
+	srv := &fakeServer{calls: make(map[string]int)}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	clt := core.NewClient("key", "secret")
+	clt.SetGateway(ts.URL + "/")
+
+	text := "会场￥AcTiViTy001￥ 带券￥CoUpOn00001￥ 无券￥AbCdEfGhIjK￥"
+	r := NewRewriter(clt, requests.GetPrivilegeLinkRequest{Pid: "mm_1_2_3"})
+	ret := r.Rewrite(text)
+
+	want := "会场￥Act999￥ 带券￥Coupon100￥ 无券￥NewTpwd100￥"
+	if ret.Text != want {
+		t.Errorf("Text = %q, want %q", ret.Text, want)
+	}
+	if failed := ret.Failed(); len(failed) != 0 {
+		t.Errorf("Failed = %+v, want none", failed)
+	}
+	if s := ret.Segments[0]; s.Activity == nil || s.GoodsID != "" {
+		t.Errorf("Segments[0] = %+v, want activity", s)
+	}
+	if s := ret.Segments[1]; s.CouponID != "c1" || s.GoodsID != "100" {
+		t.Errorf("Segments[1] = %+v, want goods 100 with coupon c1", s)
+	}
+	// 同一商品不同优惠券分别转链，活动会场使用高效转链模板的pid
+	wantCalls := map[string]int{
+		"parse:￥AcTiViTy001￥":   1,
+		"parse:￥CoUpOn00001￥":   1,
+		"parse:￥AbCdEfGhIjK￥":   1,
+		"activity:999:mm_1_2_3": 1,
+		"link:100:c1":           1,
+		"link:100":              1,
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if !reflect.DeepEqual(srv.calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", srv.calls, wantCalls)
+	}
+}
+
+func TestRender(t *testing.T) {
+	text := "前缀￥AbCdEfGhIjK￥中间https://m.tb.cn/h.x后缀"
+	tpwdStart := strings.Index(text, "￥")
+	tpwdEnd := tpwdStart + len("￥AbCdEfGhIjK￥")
+	urlStart := strings.Index(text, "https")
+	urlEnd := urlStart + len("https://m.tb.cn/h.x")
+	segments := []Segment{
+		{Replacement: "￥New￥"},
+		{Err: ErrEmptyLink},
+	}
+	segments[0].Token.Start, segments[0].Token.End = tpwdStart, tpwdEnd
+	segments[1].Token.Start, segments[1].Token.End = urlStart, urlEnd
+	if got, want := render(text, segments), "前缀￥New￥中间https://m.tb.cn/h.x后缀"; got != want {
+		t.Errorf("render = %q, want %q", got, want)
+	}
+}